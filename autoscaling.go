@@ -0,0 +1,323 @@
+package dynamodbcopy
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling/applicationautoscalingiface"
+)
+
+const indexResourceSeparator = "/index/"
+
+// AutoScalingClient is a wrapper interface over aws-sdk applicationautoscalingiface.ApplicationAutoScalingAPI
+// for mocking purposes
+type AutoScalingClient interface {
+	applicationautoscalingiface.ApplicationAutoScalingAPI
+}
+
+// NewAutoScalingClient creates an Application Auto Scaling client wrapper around the AWS-SDK,
+// configured the same way as NewDynamoClient (including the optional ARN role)
+func NewAutoScalingClient(roleArn string) AutoScalingClient {
+	return applicationautoscaling.New(newSession(roleArn))
+}
+
+// ScalableTarget abstracts the capacity limits of an application auto scaling target of a table or of one of its
+// global secondary indexes
+type ScalableTarget struct {
+	ResourceID string
+	Dimension  string
+	Min        int64
+	Max        int64
+}
+
+// Index returns the name of the global secondary index the ScalableTarget belongs to,
+// or an empty string if it belongs to the table itself
+func (t ScalableTarget) Index() string {
+	separatorIndex := strings.Index(t.ResourceID, indexResourceSeparator)
+	if separatorIndex == -1 {
+		return ""
+	}
+
+	return t.ResourceID[separatorIndex+len(indexResourceSeparator):]
+}
+
+// IsRead returns true if the ScalableTarget scales read capacity units, false if it scales write capacity units
+func (t ScalableTarget) IsRead() bool {
+	return t.Dimension == applicationautoscaling.ScalableDimensionDynamodbTableReadCapacityUnits ||
+		t.Dimension == applicationautoscaling.ScalableDimensionDynamodbIndexReadCapacityUnits
+}
+
+// AutoScalingService interface provides methods to manipulate the auto scaling targets of a table
+type AutoScalingService interface {
+	DescribeScalableTargets(indexes []string) ([]ScalableTarget, error)
+	RegisterScalableTarget(target ScalableTarget) error
+}
+
+type autoScalingService struct {
+	tableName string
+	client    AutoScalingClient
+	logger    Logger
+}
+
+// NewAutoScalingService creates new service for the auto scaling targets of a given DynamoDB table with a previously
+// configured Application Auto Scaling client
+func NewAutoScalingService(tableName string, client AutoScalingClient, logger Logger) AutoScalingService {
+	return autoScalingService{tableName, client, logger}
+}
+
+// DescribeScalableTargets returns the auto scaling targets of the table and of the given global secondary indexes,
+// only describing the resources of the table instead of every DynamoDB auto scaling target of the account
+func (s autoScalingService) DescribeScalableTargets(indexes []string) ([]ScalableTarget, error) {
+	tableResourceID := fmt.Sprintf("table/%s", s.tableName)
+	resourceIDs := []*string{aws.String(tableResourceID)}
+	for _, index := range indexes {
+		resourceIDs = append(resourceIDs, aws.String(tableResourceID+indexResourceSeparator+index))
+	}
+
+	input := &applicationautoscaling.DescribeScalableTargetsInput{
+		ServiceNamespace: aws.String(applicationautoscaling.ServiceNamespaceDynamodb),
+		ResourceIds:      resourceIDs,
+	}
+
+	var targets []ScalableTarget
+	pagerFn := func(output *applicationautoscaling.DescribeScalableTargetsOutput, lastPage bool) bool {
+		for _, target := range output.ScalableTargets {
+			resourceID := *target.ResourceId
+			if resourceID != tableResourceID && !strings.HasPrefix(resourceID, tableResourceID+indexResourceSeparator) {
+				continue
+			}
+
+			targets = append(targets, ScalableTarget{
+				ResourceID: resourceID,
+				Dimension:  *target.ScalableDimension,
+				Min:        *target.MinCapacity,
+				Max:        *target.MaxCapacity,
+			})
+		}
+
+		return !lastPage
+	}
+
+	if err := s.client.DescribeScalableTargetsPages(input, pagerFn); err != nil {
+		return nil, fmt.Errorf("unable to describe scalable targets of table %s: %s", s.tableName, err)
+	}
+
+	return targets, nil
+}
+
+// RegisterScalableTarget updates the min and max capacity of the given auto scaling target
+func (s autoScalingService) RegisterScalableTarget(target ScalableTarget) error {
+	input := &applicationautoscaling.RegisterScalableTargetInput{
+		ServiceNamespace:  aws.String(applicationautoscaling.ServiceNamespaceDynamodb),
+		ResourceId:        aws.String(target.ResourceID),
+		ScalableDimension: aws.String(target.Dimension),
+		MinCapacity:       aws.Int64(target.Min),
+		MaxCapacity:       aws.Int64(target.Max),
+	}
+
+	s.logger.Printf(
+		"registering scalable target %s %s with min: %d, max: %d",
+		target.ResourceID,
+		target.Dimension,
+		target.Min,
+		target.Max,
+	)
+	if _, err := s.client.RegisterScalableTarget(input); err != nil {
+		return fmt.Errorf("unable to register scalable target %s of table %s: %s", target.ResourceID, s.tableName, err)
+	}
+
+	return nil
+}
+
+type autoScalingProvisioner struct {
+	Provisioner
+	srcScaling AutoScalingService
+	trgScaling AutoScalingService
+	logger     Logger
+}
+
+// NewAutoScalingProvisioner wraps the given Provisioner so it also fetches and updates the auto scaling targets of
+// the source and target tables, stored in the SourceScaling and TargetScaling of the Provisioning.
+// A nil trgScalingService leaves the target auto scaling targets unset, e.g. for a NewTableProvisioner
+func NewAutoScalingProvisioner(
+	provisioner Provisioner,
+	srcScalingService,
+	trgScalingService AutoScalingService,
+	logger Logger,
+) Provisioner {
+	return autoScalingProvisioner{
+		Provisioner: provisioner,
+		srcScaling:  srcScalingService,
+		trgScaling:  trgScalingService,
+		logger:      logger,
+	}
+}
+
+// Fetch returns the current provisioning values, including the auto scaling targets, for the source and target tables
+func (p autoScalingProvisioner) Fetch() (Provisioning, error) {
+	provisioning, err := p.Provisioner.Fetch()
+	if err != nil {
+		return Provisioning{}, err
+	}
+
+	srcIndexes := sortedIndexNames(provisioning.SourceIndexes)
+	if provisioning.SourceScaling, err = p.srcScaling.DescribeScalableTargets(srcIndexes); err != nil {
+		return Provisioning{}, err
+	}
+
+	if p.trgScaling != nil {
+		trgIndexes := sortedIndexNames(provisioning.TargetIndexes)
+		if provisioning.TargetScaling, err = p.trgScaling.DescribeScalableTargets(trgIndexes); err != nil {
+			return Provisioning{}, err
+		}
+	}
+
+	p.logScalableTargets("source", provisioning.SourceScaling)
+	p.logScalableTargets("target", provisioning.TargetScaling)
+
+	return provisioning, nil
+}
+
+// Update will update the provisioning of the source and target table with the provided Provisioning value,
+// registering every auto scaling target that differs from its current min and max capacity.
+//
+// Auto scaling targets with a lower min capacity are registered before updating the tables capacity,
+// so that restoring the capacity isn't fought by auto scaling. The remaining targets are registered after updating
+// the tables capacity, so auto scaling doesn't scale the tables concurrently, and only if their table (or index)
+// capacity was actually raised.
+func (p autoScalingProvisioner) Update(provisioning Provisioning) (Provisioning, error) {
+	srcRaised, srcLowered, err := p.scalableTargetUpdates(p.srcScaling, provisioning.SourceScaling)
+	if err != nil {
+		return Provisioning{}, err
+	}
+
+	trgRaised, trgLowered, err := p.scalableTargetUpdates(p.trgScaling, provisioning.TargetScaling)
+	if err != nil {
+		return Provisioning{}, err
+	}
+
+	if err := registerScalableTargets(p.srcScaling, srcLowered); err != nil {
+		return Provisioning{}, err
+	}
+
+	if err := registerScalableTargets(p.trgScaling, trgLowered); err != nil {
+		return Provisioning{}, err
+	}
+
+	updated, err := p.Provisioner.Update(provisioning)
+	if err != nil {
+		return Provisioning{}, err
+	}
+
+	if err := registerScalableTargets(p.srcScaling, appliedScalableTargets(srcRaised, updated.source())); err != nil {
+		return Provisioning{}, err
+	}
+
+	if err := registerScalableTargets(p.trgScaling, appliedScalableTargets(trgRaised, updated.target())); err != nil {
+		return Provisioning{}, err
+	}
+
+	return updated, nil
+}
+
+func (p autoScalingProvisioner) logScalableTargets(label string, targets []ScalableTarget) {
+	for _, target := range targets {
+		p.logger.Printf(
+			"%s scalable target %s %s min: %d max: %d",
+			label,
+			target.ResourceID,
+			target.Dimension,
+			target.Min,
+			target.Max,
+		)
+	}
+}
+
+func (p autoScalingProvisioner) scalableTargetUpdates(
+	service AutoScalingService,
+	targets []ScalableTarget,
+) ([]ScalableTarget, []ScalableTarget, error) {
+	if len(targets) == 0 {
+		return nil, nil, nil
+	}
+
+	currentTargets, err := service.DescribeScalableTargets(scalableTargetIndexes(targets))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var raised, lowered []ScalableTarget
+	for _, target := range targets {
+		current, ok := findScalableTarget(currentTargets, target)
+		if !ok {
+			logWith(
+				p.logger,
+				LevelWarn,
+				Fields{"resource": target.ResourceID, "dimension": target.Dimension},
+				"skipping scalable target %s %s: no longer registered",
+				target.ResourceID,
+				target.Dimension,
+			)
+			continue
+		}
+
+		switch {
+		case current == target:
+			continue
+		case target.Min > current.Min:
+			raised = append(raised, target)
+		default:
+			lowered = append(lowered, target)
+		}
+	}
+
+	return raised, lowered, nil
+}
+
+// scalableTargetIndexes returns the names of the global secondary indexes scaled by the given targets
+func scalableTargetIndexes(targets []ScalableTarget) []string {
+	var indexes []string
+	seen := make(map[string]bool)
+	for _, target := range targets {
+		if index := target.Index(); index != "" && !seen[index] {
+			seen[index] = true
+			indexes = append(indexes, index)
+		}
+	}
+
+	return indexes
+}
+
+func findScalableTarget(targets []ScalableTarget, target ScalableTarget) (ScalableTarget, bool) {
+	for _, current := range targets {
+		if current.ResourceID == target.ResourceID && current.Dimension == target.Dimension {
+			return current, true
+		}
+	}
+
+	return ScalableTarget{}, false
+}
+
+// appliedScalableTargets filters out the raised auto scaling targets whose capacity wasn't raised by the Provisioner
+func appliedScalableTargets(targets []ScalableTarget, table tableProvisioning) []ScalableTarget {
+	var applied []ScalableTarget
+	for _, target := range targets {
+		if table.capacityUnits(target) >= target.Min {
+			applied = append(applied, target)
+		}
+	}
+
+	return applied
+}
+
+func registerScalableTargets(service AutoScalingService, targets []ScalableTarget) error {
+	for _, target := range targets {
+		if err := service.RegisterScalableTarget(target); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,429 @@
+package dynamodbcopy_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/uniplaces/dynamodbcopy"
+	"github.com/uniplaces/dynamodbcopy/mocks"
+)
+
+func TestScalableTarget(t *testing.T) {
+	t.Parallel()
+
+	tableTarget := buildScalableTarget("table/"+expectedTableName, true, 5, 10)
+	indexTarget := buildScalableTarget("table/"+expectedTableName+"/index/index", false, 5, 10)
+
+	assert.Equal(t, "", tableTarget.Index())
+	assert.True(t, tableTarget.IsRead())
+	assert.Equal(t, "index", indexTarget.Index())
+	assert.False(t, indexTarget.IsRead())
+}
+
+func TestDescribeScalableTargets(t *testing.T) {
+	t.Parallel()
+
+	expectedError := errors.New("describeScalableTargetsError")
+
+	describeInput := &applicationautoscaling.DescribeScalableTargetsInput{
+		ServiceNamespace: aws.String(applicationautoscaling.ServiceNamespaceDynamodb),
+		ResourceIds: []*string{
+			aws.String("table/" + expectedTableName),
+			aws.String("table/" + expectedTableName + "/index/index"),
+		},
+	}
+	pagerMock := mock.AnythingOfType("func(*applicationautoscaling.DescribeScalableTargetsOutput, bool) bool")
+
+	tableTarget := buildScalableTarget("table/"+expectedTableName, true, 5, 10)
+	indexTarget := buildScalableTarget("table/"+expectedTableName+"/index/index", false, 5, 10)
+	otherTarget := buildScalableTarget("table/"+expectedTableName+"-other", true, 5, 10)
+
+	testCases := []struct {
+		subTestName     string
+		mocker          func(api *mocks.ApplicationAutoScalingAPI)
+		expectedTargets []dynamodbcopy.ScalableTarget
+		errorExpected   bool
+	}{
+		{
+			"Error",
+			func(api *mocks.ApplicationAutoScalingAPI) {
+				api.On("DescribeScalableTargetsPages", describeInput, pagerMock).Return(expectedError).Once()
+			},
+			nil,
+			true,
+		},
+		{
+			"Success",
+			func(api *mocks.ApplicationAutoScalingAPI) {
+				output := &applicationautoscaling.DescribeScalableTargetsOutput{
+					ScalableTargets: []*applicationautoscaling.ScalableTarget{
+						buildAPIScalableTarget(tableTarget),
+						buildAPIScalableTarget(indexTarget),
+						buildAPIScalableTarget(otherTarget),
+					},
+				}
+
+				api.On("DescribeScalableTargetsPages", describeInput, pagerMock).Run(func(args mock.Arguments) {
+					pagerFn := args.Get(1).(func(*applicationautoscaling.DescribeScalableTargetsOutput, bool) bool)
+					pagerFn(output, true)
+				}).Return(nil).Once()
+			},
+			[]dynamodbcopy.ScalableTarget{tableTarget, indexTarget},
+			false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.subTestName,
+			func(st *testing.T) {
+				api := &mocks.ApplicationAutoScalingAPI{}
+
+				testCase.mocker(api)
+
+				service := dynamodbcopy.NewAutoScalingService(
+					expectedTableName,
+					api,
+					log.New(ioutil.Discard, "", log.Ltime),
+				)
+
+				targets, err := service.DescribeScalableTargets([]string{"index"})
+
+				assertExpectedError(st, testCase.errorExpected, err)
+				assert.Equal(st, testCase.expectedTargets, targets)
+
+				api.AssertExpectations(st)
+			},
+		)
+	}
+}
+
+func TestRegisterScalableTarget(t *testing.T) {
+	t.Parallel()
+
+	expectedError := errors.New("registerScalableTargetError")
+
+	target := buildScalableTarget("table/"+expectedTableName, true, 5, 10)
+	registerInput := &applicationautoscaling.RegisterScalableTargetInput{
+		ServiceNamespace:  aws.String(applicationautoscaling.ServiceNamespaceDynamodb),
+		ResourceId:        aws.String(target.ResourceID),
+		ScalableDimension: aws.String(target.Dimension),
+		MinCapacity:       aws.Int64(5),
+		MaxCapacity:       aws.Int64(10),
+	}
+
+	testCases := []struct {
+		subTestName   string
+		mocker        func(api *mocks.ApplicationAutoScalingAPI)
+		errorExpected bool
+	}{
+		{
+			"Error",
+			func(api *mocks.ApplicationAutoScalingAPI) {
+				api.On("RegisterScalableTarget", registerInput).Return(nil, expectedError).Once()
+			},
+			true,
+		},
+		{
+			"Success",
+			func(api *mocks.ApplicationAutoScalingAPI) {
+				output := &applicationautoscaling.RegisterScalableTargetOutput{}
+				api.On("RegisterScalableTarget", registerInput).Return(output, nil).Once()
+			},
+			false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.subTestName,
+			func(st *testing.T) {
+				api := &mocks.ApplicationAutoScalingAPI{}
+
+				testCase.mocker(api)
+
+				service := dynamodbcopy.NewAutoScalingService(
+					expectedTableName,
+					api,
+					log.New(ioutil.Discard, "", log.Ltime),
+				)
+
+				err := service.RegisterScalableTarget(target)
+
+				assertExpectedError(st, testCase.errorExpected, err)
+
+				api.AssertExpectations(st)
+			},
+		)
+	}
+}
+
+func TestAutoScalingProvisionerFetch(t *testing.T) {
+	t.Parallel()
+
+	expectedError := errors.New("fetchError")
+
+	srcTargets := []dynamodbcopy.ScalableTarget{buildScalableTarget("table/"+srcTableName, true, 5, 10)}
+	trgTargets := []dynamodbcopy.ScalableTarget{buildScalableTarget("table/"+trgTableName, false, 5, 10)}
+	indexProvisioning := dynamodbcopy.Provisioning{
+		SourceIndexes: map[string]*dynamodbcopy.Capacity{"index": {Read: 5, Write: 5}},
+	}
+
+	testCases := []struct {
+		subTestName          string
+		mocker               func(provisioner *mocks.Provisioner, src, trg *mocks.AutoScalingService)
+		expectedProvisioning dynamodbcopy.Provisioning
+		expectedError        error
+	}{
+		{
+			"FetchError",
+			func(provisioner *mocks.Provisioner, src, trg *mocks.AutoScalingService) {
+				provisioner.On("Fetch").Return(dynamodbcopy.Provisioning{}, expectedError).Once()
+			},
+			dynamodbcopy.Provisioning{},
+			expectedError,
+		},
+		{
+			"DescribeError",
+			func(provisioner *mocks.Provisioner, src, trg *mocks.AutoScalingService) {
+				provisioner.On("Fetch").Return(dynamodbcopy.Provisioning{}, nil).Once()
+				src.On("DescribeScalableTargets", []string{}).Return(nil, expectedError).Once()
+			},
+			dynamodbcopy.Provisioning{},
+			expectedError,
+		},
+		{
+			"Success",
+			func(provisioner *mocks.Provisioner, src, trg *mocks.AutoScalingService) {
+				provisioner.On("Fetch").Return(indexProvisioning, nil).Once()
+				src.On("DescribeScalableTargets", []string{"index"}).Return(srcTargets, nil).Once()
+				trg.On("DescribeScalableTargets", []string{}).Return(trgTargets, nil).Once()
+			},
+			dynamodbcopy.Provisioning{
+				SourceIndexes: indexProvisioning.SourceIndexes,
+				SourceScaling: srcTargets,
+				TargetScaling: trgTargets,
+			},
+			nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.subTestName,
+			func(st *testing.T) {
+				provisionerMock := &mocks.Provisioner{}
+				srcScaling := &mocks.AutoScalingService{}
+				trgScaling := &mocks.AutoScalingService{}
+
+				testCase.mocker(provisionerMock, srcScaling, trgScaling)
+
+				provisioner := dynamodbcopy.NewAutoScalingProvisioner(
+					provisionerMock,
+					srcScaling,
+					trgScaling,
+					log.New(ioutil.Discard, "", log.Ltime),
+				)
+
+				provisioning, err := provisioner.Fetch()
+
+				assert.Equal(st, testCase.expectedProvisioning, provisioning)
+				assert.Equal(st, testCase.expectedError, err)
+
+				provisionerMock.AssertExpectations(st)
+				srcScaling.AssertExpectations(st)
+				trgScaling.AssertExpectations(st)
+			},
+		)
+	}
+}
+
+func TestAutoScalingProvisionerFetchWithoutTarget(t *testing.T) {
+	t.Parallel()
+
+	srcTargets := []dynamodbcopy.ScalableTarget{buildScalableTarget("table/"+srcTableName, true, 5, 10)}
+
+	provisionerMock := &mocks.Provisioner{}
+	provisionerMock.On("Fetch").Return(dynamodbcopy.Provisioning{}, nil).Once()
+	srcScaling := &mocks.AutoScalingService{}
+	srcScaling.On("DescribeScalableTargets", []string{}).Return(srcTargets, nil).Once()
+
+	provisioner := dynamodbcopy.NewAutoScalingProvisioner(
+		provisionerMock,
+		srcScaling,
+		nil,
+		log.New(ioutil.Discard, "", log.Ltime),
+	)
+
+	provisioning, err := provisioner.Fetch()
+
+	assert.Nil(t, err)
+	assert.Equal(t, dynamodbcopy.Provisioning{SourceScaling: srcTargets}, provisioning)
+
+	provisionerMock.AssertExpectations(t)
+	srcScaling.AssertExpectations(t)
+}
+
+func TestAutoScalingProvisionerUpdate(t *testing.T) {
+	t.Parallel()
+
+	expectedError := errors.New("updateError")
+
+	srcTarget := buildScalableTarget("table/"+srcTableName, true, 5, 10)
+	raisedSrcTarget := buildScalableTarget("table/"+srcTableName, true, 20, 20)
+	trgTarget := buildScalableTarget("table/"+trgTableName, false, 5, 10)
+	deregisteredTarget := buildScalableTarget("table/"+trgTableName+"/index/index", false, 5, 10)
+
+	raisedProvisioning := dynamodbcopy.Provisioning{
+		Source:        &dynamodbcopy.Capacity{Read: 20, Write: 5},
+		SourceScaling: []dynamodbcopy.ScalableTarget{raisedSrcTarget},
+		TargetScaling: []dynamodbcopy.ScalableTarget{trgTarget, deregisteredTarget},
+	}
+	restoredProvisioning := dynamodbcopy.Provisioning{
+		SourceScaling: []dynamodbcopy.ScalableTarget{srcTarget},
+	}
+	noIndexes := []string(nil)
+
+	testCases := []struct {
+		subTestName          string
+		mocker               func(provisioner *mocks.Provisioner, src, trg *mocks.AutoScalingService)
+		provisioning         dynamodbcopy.Provisioning
+		expectedProvisioning dynamodbcopy.Provisioning
+		expectedError        error
+	}{
+		{
+			"DescribeError",
+			func(provisioner *mocks.Provisioner, src, trg *mocks.AutoScalingService) {
+				src.On("DescribeScalableTargets", noIndexes).Return(nil, expectedError).Once()
+			},
+			raisedProvisioning,
+			dynamodbcopy.Provisioning{},
+			expectedError,
+		},
+		{
+			"UpdateError",
+			func(provisioner *mocks.Provisioner, src, trg *mocks.AutoScalingService) {
+				src.On("DescribeScalableTargets", noIndexes).Return([]dynamodbcopy.ScalableTarget{srcTarget}, nil).Once()
+				trg.On("DescribeScalableTargets", []string{"index"}).Return([]dynamodbcopy.ScalableTarget{trgTarget}, nil).Once()
+				provisioner.On("Update", raisedProvisioning).Return(dynamodbcopy.Provisioning{}, expectedError).Once()
+			},
+			raisedProvisioning,
+			dynamodbcopy.Provisioning{},
+			expectedError,
+		},
+		{
+			"Raise",
+			func(provisioner *mocks.Provisioner, src, trg *mocks.AutoScalingService) {
+				src.On("DescribeScalableTargets", noIndexes).Return([]dynamodbcopy.ScalableTarget{srcTarget}, nil).Once()
+				trg.On("DescribeScalableTargets", []string{"index"}).Return([]dynamodbcopy.ScalableTarget{trgTarget}, nil).Once()
+				provisioner.On("Update", raisedProvisioning).Return(raisedProvisioning, nil).Once()
+				src.On("RegisterScalableTarget", raisedSrcTarget).Return(nil).Once()
+			},
+			raisedProvisioning,
+			raisedProvisioning,
+			nil,
+		},
+		{
+			"RaiseSkipped",
+			func(provisioner *mocks.Provisioner, src, trg *mocks.AutoScalingService) {
+				src.On("DescribeScalableTargets", noIndexes).Return([]dynamodbcopy.ScalableTarget{srcTarget}, nil).Once()
+				trg.On("DescribeScalableTargets", []string{"index"}).Return([]dynamodbcopy.ScalableTarget{trgTarget}, nil).Once()
+				provisioner.On("Update", raisedProvisioning).Return(restoredProvisioning, nil).Once()
+			},
+			raisedProvisioning,
+			restoredProvisioning,
+			nil,
+		},
+		{
+			"RaiseRegisterError",
+			func(provisioner *mocks.Provisioner, src, trg *mocks.AutoScalingService) {
+				src.On("DescribeScalableTargets", noIndexes).Return([]dynamodbcopy.ScalableTarget{srcTarget}, nil).Once()
+				trg.On("DescribeScalableTargets", []string{"index"}).Return([]dynamodbcopy.ScalableTarget{trgTarget}, nil).Once()
+				provisioner.On("Update", raisedProvisioning).Return(raisedProvisioning, nil).Once()
+				src.On("RegisterScalableTarget", raisedSrcTarget).Return(expectedError).Once()
+			},
+			raisedProvisioning,
+			dynamodbcopy.Provisioning{},
+			expectedError,
+		},
+		{
+			"Restore",
+			func(provisioner *mocks.Provisioner, src, trg *mocks.AutoScalingService) {
+				src.On("DescribeScalableTargets", noIndexes).Return([]dynamodbcopy.ScalableTarget{raisedSrcTarget}, nil).Once()
+				src.On("RegisterScalableTarget", srcTarget).Return(nil).Once()
+				provisioner.On("Update", restoredProvisioning).Return(restoredProvisioning, nil).Once()
+			},
+			restoredProvisioning,
+			restoredProvisioning,
+			nil,
+		},
+		{
+			"RestoreRegisterError",
+			func(provisioner *mocks.Provisioner, src, trg *mocks.AutoScalingService) {
+				src.On("DescribeScalableTargets", noIndexes).Return([]dynamodbcopy.ScalableTarget{raisedSrcTarget}, nil).Once()
+				src.On("RegisterScalableTarget", srcTarget).Return(expectedError).Once()
+			},
+			restoredProvisioning,
+			dynamodbcopy.Provisioning{},
+			expectedError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.subTestName,
+			func(st *testing.T) {
+				provisionerMock := &mocks.Provisioner{}
+				srcScaling := &mocks.AutoScalingService{}
+				trgScaling := &mocks.AutoScalingService{}
+
+				testCase.mocker(provisionerMock, srcScaling, trgScaling)
+
+				provisioner := dynamodbcopy.NewAutoScalingProvisioner(
+					provisionerMock,
+					srcScaling,
+					trgScaling,
+					log.New(ioutil.Discard, "", log.Ltime),
+				)
+
+				provisioning, err := provisioner.Update(testCase.provisioning)
+
+				assert.Equal(st, testCase.expectedProvisioning, provisioning)
+				assert.Equal(st, testCase.expectedError, err)
+
+				provisionerMock.AssertExpectations(st)
+				srcScaling.AssertExpectations(st)
+				trgScaling.AssertExpectations(st)
+			},
+		)
+	}
+}
+
+func buildScalableTarget(resourceID string, read bool, min, max int64) dynamodbcopy.ScalableTarget {
+	dimension := applicationautoscaling.ScalableDimensionDynamodbTableWriteCapacityUnits
+	if read {
+		dimension = applicationautoscaling.ScalableDimensionDynamodbTableReadCapacityUnits
+	}
+
+	return dynamodbcopy.ScalableTarget{
+		ResourceID: resourceID,
+		Dimension:  dimension,
+		Min:        min,
+		Max:        max,
+	}
+}
+
+func buildAPIScalableTarget(target dynamodbcopy.ScalableTarget) *applicationautoscaling.ScalableTarget {
+	return &applicationautoscaling.ScalableTarget{
+		ResourceId:        aws.String(target.ResourceID),
+		ScalableDimension: aws.String(target.Dimension),
+		MinCapacity:       aws.Int64(target.Min),
+		MaxCapacity:       aws.Int64(target.Max),
+	}
+}
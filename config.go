@@ -1,11 +1,42 @@
 package dynamodbcopy
 
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+// OnDemandPolicy defines how the copy handles tables with an on-demand (BillingModePayPerRequest) billing mode
+type OnDemandPolicy string
+
+const (
+	// OnDemandKeep leaves on-demand tables untouched, using the capacity units as a rate limit for the copy
+	OnDemandKeep OnDemandPolicy = "keep"
+	// OnDemandSwitch switches on-demand tables to provisioned during the copy, switching them back afterwards
+	OnDemandSwitch OnDemandPolicy = "switch"
+)
+
+// ParseOnDemandPolicy returns the OnDemandPolicy that matches the given value
+func ParseOnDemandPolicy(value string) (OnDemandPolicy, error) {
+	switch policy := OnDemandPolicy(value); policy {
+	case OnDemandKeep, OnDemandSwitch:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("invalid on-demand policy %q: must be one of %s, %s", value, OnDemandKeep, OnDemandSwitch)
+	}
+}
+
 // Config encapsulates the values nedeed for the command
 type Config struct {
 	readCapacityUnits  int64
 	writeCapacityUnits int64
 	readWorkers        int
 	writeWorkers       int
+	onDemandPolicy     OnDemandPolicy
+	rampStep           int64
+	rampInterval       time.Duration
+	rampDownSteps      int
 }
 
 // NewConfig creates a new Config to store the parameters user defined parameters
@@ -15,23 +46,163 @@ func NewConfig(readUnits, writeUnits, readWorkers, writeWorkers int) Config {
 		writeCapacityUnits: int64(writeUnits),
 		readWorkers:        readWorkers,
 		writeWorkers:       writeWorkers,
+		onDemandPolicy:     OnDemandKeep,
 	}
 }
 
+// WithOnDemandPolicy returns a copy of the Config (receiver) using the given OnDemandPolicy
+func (c Config) WithOnDemandPolicy(policy OnDemandPolicy) Config {
+	c.onDemandPolicy = policy
+
+	return c
+}
+
+// WithRamp returns a copy of the Config (receiver) that ramps the capacity units up in steps of the given units,
+// evaluated every interval, and down in the given number of steps. A step of 0 disables the ramp
+func (c Config) WithRamp(step int64, interval time.Duration, downSteps int) Config {
+	c.rampStep = step
+	c.rampInterval = interval
+	c.rampDownSteps = downSteps
+
+	return c
+}
+
+// withCapacityUnits returns a copy of the Config (receiver) using the given read and write capacity units
+func (c Config) withCapacityUnits(read, write int64) Config {
+	c.readCapacityUnits = read
+	c.writeCapacityUnits = write
+
+	return c
+}
+
 // Provisioning calculates a new Provisioning value based on the passed argument and the current Config (receiver).
-// The returned Provisioning value will have the higher values for read and write capacity units of the 2
+// The returned Provisioning value will have the higher values for read and write capacity units of the 2.
+// Since writes into the target table also consume the write capacity of its global secondary indexes,
+// the same applies to the write capacity units of each target index.
+//
+// The min capacity of the auto scaling targets of the raised capacities is raised to the resulting capacity units,
+// so auto scaling doesn't fight the updated capacity during the copy.
+//
+// With the OnDemandSwitch policy, on-demand tables are switched to provisioned with the configured capacity units
+// for both read and write capacity. Otherwise, on-demand tables are kept as they are.
 func (c Config) Provisioning(current Provisioning) Provisioning {
-	src := current.Source
+	src, srcBillingMode := current.Source, current.SourceBillingMode
 	if src != nil && c.readCapacityUnits > src.Read {
 		src = &Capacity{Read: c.readCapacityUnits, Write: src.Write}
 	}
 
-	trg := current.Target
+	if c.switchesOnDemand(srcBillingMode, c.readCapacityUnits) {
+		src = &Capacity{Read: c.readCapacityUnits, Write: c.readCapacityUnits}
+		srcBillingMode = dynamodb.BillingModeProvisioned
+	}
+
+	trg, trgBillingMode := current.Target, current.TargetBillingMode
 	if trg != nil && c.writeCapacityUnits > trg.Write {
 		trg = &Capacity{Read: trg.Read, Write: c.writeCapacityUnits}
 	}
 
-	return Provisioning{Source: src, Target: trg}
+	var trgIndexes map[string]*Capacity
+	if current.TargetIndexes != nil {
+		trgIndexes = make(map[string]*Capacity, len(current.TargetIndexes))
+		for index, capacity := range current.TargetIndexes {
+			if c.writeCapacityUnits > capacity.Write {
+				capacity = &Capacity{Read: capacity.Read, Write: c.writeCapacityUnits}
+			}
+			trgIndexes[index] = capacity
+		}
+	}
+
+	if c.switchesOnDemand(trgBillingMode, c.writeCapacityUnits) {
+		trg = &Capacity{Read: c.writeCapacityUnits, Write: c.writeCapacityUnits}
+		trgBillingMode = dynamodb.BillingModeProvisioned
+	}
+
+	provisioning := Provisioning{
+		Source:            src,
+		Target:            trg,
+		SourceIndexes:     current.SourceIndexes,
+		TargetIndexes:     trgIndexes,
+		SourceBillingMode: srcBillingMode,
+		TargetBillingMode: trgBillingMode,
+	}
+	provisioning.SourceScaling = raiseScalableTargets(current.SourceScaling, current.source(), provisioning.source())
+	provisioning.TargetScaling = raiseScalableTargets(current.TargetScaling, current.target(), provisioning.target())
+
+	return provisioning
+}
+
+// TableProvisioning calculates a new Provisioning value for commands that read from and write into a single table,
+// the source table, raising both its read and write capacity units (and the write capacity units of its global
+// secondary indexes) like Provisioning does. The target table is left unchanged.
+//
+// The Provisioner updating the returned Provisioning should be created with NewTableProvisioner
+func (c Config) TableProvisioning(current Provisioning) Provisioning {
+	provisioning := current
+
+	if src := current.Source; src != nil {
+		raised := Capacity{Read: max64(c.readCapacityUnits, src.Read), Write: max64(c.writeCapacityUnits, src.Write)}
+		if raised != *src {
+			provisioning.Source = &raised
+		}
+	}
+
+	if current.SourceIndexes != nil {
+		provisioning.SourceIndexes = make(map[string]*Capacity, len(current.SourceIndexes))
+		for index, capacity := range current.SourceIndexes {
+			if c.writeCapacityUnits > capacity.Write {
+				capacity = &Capacity{Read: capacity.Read, Write: c.writeCapacityUnits}
+			}
+			provisioning.SourceIndexes[index] = capacity
+		}
+	}
+	provisioning.SourceScaling = raiseScalableTargets(current.SourceScaling, current.source(), provisioning.source())
+
+	return provisioning
+}
+
+// raiseScalableTargets raises the min capacity of each auto scaling target whose capacity units are raised to the
+// updated capacity units, so that auto scaling doesn't scale in the table during the copy.
+// The max capacity is raised as well if needed
+func raiseScalableTargets(targets []ScalableTarget, current, update tableProvisioning) []ScalableTarget {
+	if targets == nil {
+		return nil
+	}
+
+	raised := make([]ScalableTarget, len(targets))
+	for i, target := range targets {
+		units := update.capacityUnits(target)
+		if units > current.capacityUnits(target) && units > target.Min {
+			target.Min = units
+		}
+
+		if target.Min > target.Max {
+			target.Max = target.Min
+		}
+		raised[i] = target
+	}
+
+	return raised
+}
+
+func (c Config) switchesOnDemand(billingMode string, units int64) bool {
+	return billingMode == dynamodb.BillingModePayPerRequest && c.onDemandPolicy == OnDemandSwitch && units > 0
+}
+
+// RateLimits returns the read and write units per second the copy should be limited to.
+//
+// Tables that remain on-demand with the given Provisioning are limited by the configured capacity units,
+// while the remaining tables are not limited (0)
+func (c Config) RateLimits(provisioning Provisioning) (int64, int64) {
+	var read, write int64
+	if provisioning.SourceBillingMode == dynamodb.BillingModePayPerRequest {
+		read = c.readCapacityUnits
+	}
+
+	if provisioning.TargetBillingMode == dynamodb.BillingModePayPerRequest {
+		write = c.writeCapacityUnits
+	}
+
+	return read, write
 }
 
 // Workers returns the Config read and write worker count
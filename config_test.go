@@ -3,6 +3,8 @@ package dynamodbcopy_test
 import (
 	"testing"
 
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/stretchr/testify/assert"
 	"github.com/uniplaces/dynamodbcopy"
 )
@@ -57,6 +59,47 @@ func TestProvisioning(t *testing.T) {
 			buildProvision(10, 10),
 			buildProvision(12, 12),
 		},
+		{
+			"UpdateIndexProvisioning",
+			dynamodbcopy.NewConfig(12, 12, 1, 1),
+			buildIndexProvision(10, map[string]int64{"index1": 10, "index2": 15}),
+			buildIndexProvision(12, map[string]int64{"index1": 12, "index2": 15}),
+		},
+		{
+			"UpdateScalingProvisioning",
+			dynamodbcopy.NewConfig(12, 12, 1, 1),
+			buildScalingProvision(10, 10, 5, 10),
+			buildScalingProvision(12, 12, 12, 12),
+		},
+		{
+			"NoUpdateScalingProvisioning",
+			dynamodbcopy.NewConfig(0, 0, 1, 1),
+			buildScalingProvision(10, 10, 5, 10),
+			buildScalingProvision(10, 10, 5, 10),
+		},
+		{
+			"KeepOnDemand",
+			dynamodbcopy.NewConfig(12, 12, 1, 1),
+			buildOnDemandProvision(),
+			buildOnDemandProvision(),
+		},
+		{
+			"SwitchOnDemand",
+			dynamodbcopy.NewConfig(12, 15, 1, 1).WithOnDemandPolicy(dynamodbcopy.OnDemandSwitch),
+			buildOnDemandProvision(),
+			dynamodbcopy.Provisioning{
+				Source:            &dynamodbcopy.Capacity{Read: 12, Write: 12},
+				Target:            &dynamodbcopy.Capacity{Read: 15, Write: 15},
+				SourceBillingMode: dynamodb.BillingModeProvisioned,
+				TargetBillingMode: dynamodb.BillingModeProvisioned,
+			},
+		},
+		{
+			"SwitchOnDemandWithoutCapacity",
+			dynamodbcopy.NewConfig(0, 0, 1, 1).WithOnDemandPolicy(dynamodbcopy.OnDemandSwitch),
+			buildOnDemandProvision(),
+			buildOnDemandProvision(),
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -71,6 +114,115 @@ func TestProvisioning(t *testing.T) {
 	}
 }
 
+func TestTableProvisioning(t *testing.T) {
+	t.Parallel()
+
+	writeDimension := applicationautoscaling.ScalableDimensionDynamodbTableWriteCapacityUnits
+
+	current := dynamodbcopy.Provisioning{
+		Source:        &dynamodbcopy.Capacity{Read: 10, Write: 10},
+		Target:        &dynamodbcopy.Capacity{Read: 10, Write: 10},
+		SourceIndexes: map[string]*dynamodbcopy.Capacity{"index": {Read: 5, Write: 5}},
+		TargetIndexes: map[string]*dynamodbcopy.Capacity{"index": {Read: 5, Write: 5}},
+		SourceScaling: []dynamodbcopy.ScalableTarget{
+			{ResourceID: "table/src", Dimension: writeDimension, Min: 5, Max: 20},
+		},
+	}
+
+	expected := current
+	expected.Source = &dynamodbcopy.Capacity{Read: 15, Write: 30}
+	expected.SourceIndexes = map[string]*dynamodbcopy.Capacity{"index": {Read: 5, Write: 30}}
+	expected.SourceScaling = []dynamodbcopy.ScalableTarget{
+		{ResourceID: "table/src", Dimension: writeDimension, Min: 30, Max: 30},
+	}
+
+	provisioning := dynamodbcopy.NewConfig(15, 30, 1, 1).TableProvisioning(current)
+
+	assert.Equal(t, expected, provisioning)
+	assert.Equal(t, current, dynamodbcopy.NewConfig(5, 5, 1, 1).TableProvisioning(current))
+}
+
+func TestRateLimits(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		subTestName   string
+		config        dynamodbcopy.Config
+		provisioning  dynamodbcopy.Provisioning
+		expectedRead  int64
+		expectedWrite int64
+	}{
+		{
+			"Provisioned",
+			dynamodbcopy.NewConfig(12, 15, 1, 1),
+			buildProvision(10, 10),
+			0,
+			0,
+		},
+		{
+			"OnDemand",
+			dynamodbcopy.NewConfig(12, 15, 1, 1),
+			buildOnDemandProvision(),
+			12,
+			15,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.subTestName,
+			func(st *testing.T) {
+				read, write := testCase.config.RateLimits(testCase.provisioning)
+
+				assert.Equal(st, testCase.expectedRead, read)
+				assert.Equal(st, testCase.expectedWrite, write)
+			},
+		)
+	}
+}
+
+func TestParseOnDemandPolicy(t *testing.T) {
+	t.Parallel()
+
+	policy, err := dynamodbcopy.ParseOnDemandPolicy("switch")
+
+	assert.Nil(t, err)
+	assert.Equal(t, dynamodbcopy.OnDemandSwitch, policy)
+
+	_, err = dynamodbcopy.ParseOnDemandPolicy("invalid")
+
+	assert.NotNil(t, err)
+}
+
+func buildIndexProvision(w int64, indexes map[string]int64) dynamodbcopy.Provisioning {
+	provisioning := buildProvision(0, w)
+	provisioning.TargetIndexes = map[string]*dynamodbcopy.Capacity{}
+	for index, indexWrite := range indexes {
+		provisioning.TargetIndexes[index] = &dynamodbcopy.Capacity{Read: 5, Write: indexWrite}
+	}
+
+	return provisioning
+}
+
+func buildScalingProvision(r, w, min, max int64) dynamodbcopy.Provisioning {
+	provisioning := buildProvision(r, w)
+	provisioning.SourceScaling = []dynamodbcopy.ScalableTarget{
+		{ResourceID: "table/src", Dimension: "dynamodb:table:ReadCapacityUnits", Min: min, Max: max},
+	}
+	provisioning.TargetScaling = []dynamodbcopy.ScalableTarget{
+		{ResourceID: "table/trg", Dimension: "dynamodb:table:WriteCapacityUnits", Min: min, Max: max},
+	}
+
+	return provisioning
+}
+
+func buildOnDemandProvision() dynamodbcopy.Provisioning {
+	return dynamodbcopy.Provisioning{
+		SourceBillingMode: dynamodb.BillingModePayPerRequest,
+		TargetBillingMode: dynamodb.BillingModePayPerRequest,
+	}
+}
+
 func buildProvision(r, w int64) dynamodbcopy.Provisioning {
 	var src *dynamodbcopy.Capacity
 	var trg *dynamodbcopy.Capacity
@@ -0,0 +1,195 @@
+package dynamodbcopy
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+// ConflictMode defines how the copy handles items that already exist in the target table
+type ConflictMode string
+
+const (
+	// ConflictOverwrite overwrites existing target items
+	ConflictOverwrite ConflictMode = "overwrite"
+	// ConflictSkip keeps existing target items, skipping the source items
+	ConflictSkip ConflictMode = "skip"
+	// ConflictFail fails the copy when an item already exists in the target table
+	ConflictFail ConflictMode = "fail"
+	// ConflictNewerWins only overwrites existing target items that are older than the source items
+	ConflictNewerWins ConflictMode = "newer-wins"
+)
+
+// ParseConflictMode returns the ConflictMode that matches the given value
+func ParseConflictMode(value string) (ConflictMode, error) {
+	switch mode := ConflictMode(value); mode {
+	case ConflictOverwrite, ConflictSkip, ConflictFail, ConflictNewerWins:
+		return mode, nil
+	default:
+		return "", fmt.Errorf(
+			"invalid conflict mode %q: must be one of %s, %s, %s, %s",
+			value,
+			ConflictOverwrite,
+			ConflictSkip,
+			ConflictFail,
+			ConflictNewerWins,
+		)
+	}
+}
+
+type hashKey struct {
+	once *sync.Once
+	name string
+	err  error
+}
+
+type conflictResolvingService struct {
+	DynamoDBService
+	mode           ConflictMode
+	newerAttribute string
+	hashKey        *hashKey
+	report         *WriteReport
+	logger         Logger
+}
+
+// NewConflictResolvingDynamoDBService wraps a DynamoDBService so that its batch writes handle the items that already
+// exist in the table according to the given ConflictMode.
+//
+// Apart from ConflictOverwrite, items are written one by one with conditional puts. TransactWriteItems isn't used,
+// since a single failed condition would cancel the writes of the whole transaction.
+// With ConflictNewerWins, an existing item is only overwritten if the value of its newerAttribute (e.g. an updatedAt
+// timestamp or a numeric version) is lower than the source item's value, both being numbers or strings.
+// Existing items without the attribute are never overwritten, while source items without it are only written when
+// no item with the same key exists, being skipped otherwise.
+//
+// The outcome of every write is counted in the given WriteReport
+func NewConflictResolvingDynamoDBService(
+	service DynamoDBService,
+	mode ConflictMode,
+	newerAttribute string,
+	report *WriteReport,
+	logger Logger,
+) DynamoDBService {
+	return conflictResolvingService{
+		DynamoDBService: service,
+		mode:            mode,
+		newerAttribute:  newerAttribute,
+		hashKey:         &hashKey{once: &sync.Once{}},
+		report:          report,
+		logger:          logger,
+	}
+}
+
+// BatchWrite writes the items, resolving the conflicts with the existing items according to the ConflictMode
+func (service conflictResolvingService) BatchWrite(items []DynamoDBItem) error {
+	if service.mode == ConflictOverwrite {
+		if err := service.DynamoDBService.BatchWrite(items); err != nil {
+			return err
+		}
+		atomic.AddInt64(&service.report.written, int64(len(items)))
+
+		return nil
+	}
+
+	keyName, err := service.hashKeyName()
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		condition, compared := service.condition(keyName, item)
+
+		written, err := service.ConditionalPut(item, condition)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case written:
+			atomic.AddInt64(&service.report.written, 1)
+		case service.mode == ConflictFail:
+			return fmt.Errorf(
+				"item with key %s %s already exists in target table",
+				keyName,
+				formatAttributeValue(item[keyName]),
+			)
+		case service.mode == ConflictNewerWins && !compared:
+			logWith(
+				service.logger,
+				LevelWarn,
+				Fields{"key": formatAttributeValue(item[keyName])},
+				"skipped existing item with key %s %s: the source item has no %s attribute",
+				keyName,
+				formatAttributeValue(item[keyName]),
+				service.newerAttribute,
+			)
+			atomic.AddInt64(&service.report.skippedWithoutAttribute, 1)
+		case service.mode == ConflictNewerWins:
+			atomic.AddInt64(&service.report.skippedOlder, 1)
+		default:
+			atomic.AddInt64(&service.report.skippedExisting, 1)
+		}
+	}
+
+	return nil
+}
+
+// condition returns the WriteCondition of the conditional put of the item, and whether it compares the newerAttribute.
+// Items without the newerAttribute are only written when no item with the same key exists
+func (service conflictResolvingService) condition(keyName string, item DynamoDBItem) (WriteCondition, bool) {
+	condition := WriteCondition{
+		Expression: "attribute_not_exists(#key)",
+		Names:      map[string]*string{"#key": aws.String(keyName)},
+	}
+
+	value, ok := item[service.newerAttribute]
+	if service.mode != ConflictNewerWins || !ok {
+		return condition, false
+	}
+
+	condition.Expression = "attribute_not_exists(#key) OR #newer < :newer"
+	condition.Names["#newer"] = aws.String(service.newerAttribute)
+	condition.Values = map[string]*dynamodb.AttributeValue{":newer": value}
+
+	return condition, true
+}
+
+// hashKeyName returns the name of the table hash key, describing the table only once
+func (service conflictResolvingService) hashKeyName() (string, error) {
+	service.hashKey.once.Do(func() {
+		description, err := service.DescribeTable()
+		if err != nil {
+			service.hashKey.err = err
+
+			return
+		}
+
+		for _, key := range description.KeySchema {
+			if aws.StringValue(key.KeyType) == dynamodb.KeyTypeHash {
+				service.hashKey.name = aws.StringValue(key.AttributeName)
+
+				return
+			}
+		}
+		service.hashKey.err = errors.New("table without hash key")
+	})
+
+	return service.hashKey.name, service.hashKey.err
+}
+
+func formatAttributeValue(value *dynamodb.AttributeValue) string {
+	switch {
+	case value == nil:
+		return ""
+	case value.S != nil:
+		return aws.StringValue(value.S)
+	case value.N != nil:
+		return aws.StringValue(value.N)
+	default:
+		return fmt.Sprintf("%x", value.B)
+	}
+}
@@ -0,0 +1,163 @@
+package dynamodbcopy_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/uniplaces/dynamodbcopy"
+	"github.com/uniplaces/dynamodbcopy/mocks"
+)
+
+func TestConflictResolvingBatchWrite(t *testing.T) {
+	t.Parallel()
+
+	description := buildKeyedTableDescription(trgTableName, "id", dynamodb.ScalarAttributeTypeS)
+	items := []dynamodbcopy.DynamoDBItem{
+		{"id": {S: aws.String("1")}, "version": {N: aws.String("2")}},
+		{"id": {S: aws.String("2")}},
+	}
+
+	notExists := dynamodbcopy.WriteCondition{
+		Expression: "attribute_not_exists(#key)",
+		Names:      map[string]*string{"#key": aws.String("id")},
+	}
+	newer := dynamodbcopy.WriteCondition{
+		Expression: "attribute_not_exists(#key) OR #newer < :newer",
+		Names:      map[string]*string{"#key": aws.String("id"), "#newer": aws.String("version")},
+		Values:     map[string]*dynamodb.AttributeValue{":newer": {N: aws.String("2")}},
+	}
+
+	expectedError := errors.New("write error")
+
+	testCases := []struct {
+		subTestName   string
+		mode          dynamodbcopy.ConflictMode
+		mocker        func(service *mocks.DynamoDBService)
+		expectedError bool
+		expectedCount [4]int64
+	}{
+		{
+			"Overwrite",
+			dynamodbcopy.ConflictOverwrite,
+			func(service *mocks.DynamoDBService) {
+				service.On("BatchWrite", items).Return(nil).Once()
+			},
+			false,
+			[4]int64{2, 0, 0, 0},
+		},
+		{
+			"DescribeError",
+			dynamodbcopy.ConflictSkip,
+			func(service *mocks.DynamoDBService) {
+				service.On("DescribeTable").Return(nil, expectedError).Once()
+			},
+			true,
+			[4]int64{},
+		},
+		{
+			"Skip",
+			dynamodbcopy.ConflictSkip,
+			func(service *mocks.DynamoDBService) {
+				service.On("DescribeTable").Return(&description, nil).Once()
+				service.On("ConditionalPut", items[0], notExists).Return(false, nil).Once()
+				service.On("ConditionalPut", items[1], notExists).Return(true, nil).Once()
+			},
+			false,
+			[4]int64{1, 1, 0, 0},
+		},
+		{
+			"PutError",
+			dynamodbcopy.ConflictSkip,
+			func(service *mocks.DynamoDBService) {
+				service.On("DescribeTable").Return(&description, nil).Once()
+				service.On("ConditionalPut", items[0], notExists).Return(false, expectedError).Once()
+			},
+			true,
+			[4]int64{},
+		},
+		{
+			"Fail",
+			dynamodbcopy.ConflictFail,
+			func(service *mocks.DynamoDBService) {
+				service.On("DescribeTable").Return(&description, nil).Once()
+				service.On("ConditionalPut", items[0], notExists).Return(false, nil).Once()
+			},
+			true,
+			[4]int64{},
+		},
+		{
+			"NewerWins",
+			dynamodbcopy.ConflictNewerWins,
+			func(service *mocks.DynamoDBService) {
+				service.On("DescribeTable").Return(&description, nil).Once()
+				service.On("ConditionalPut", items[0], newer).Return(false, nil).Once()
+				service.On("ConditionalPut", items[1], notExists).Return(false, nil).Once()
+			},
+			false,
+			[4]int64{0, 0, 1, 1},
+		},
+		{
+			"NewerWinsWithoutAttributeMissingKey",
+			dynamodbcopy.ConflictNewerWins,
+			func(service *mocks.DynamoDBService) {
+				service.On("DescribeTable").Return(&description, nil).Once()
+				service.On("ConditionalPut", items[0], newer).Return(true, nil).Once()
+				service.On("ConditionalPut", items[1], notExists).Return(true, nil).Once()
+			},
+			false,
+			[4]int64{2, 0, 0, 0},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.subTestName,
+			func(st *testing.T) {
+				service := &mocks.DynamoDBService{}
+
+				testCase.mocker(service)
+
+				report := dynamodbcopy.NewWriteReport()
+				conflictService := dynamodbcopy.NewConflictResolvingDynamoDBService(
+					service,
+					testCase.mode,
+					"version",
+					report,
+					log.New(ioutil.Discard, "", log.Ltime),
+				)
+
+				err := conflictService.BatchWrite(items)
+
+				assertExpectedError(st, testCase.expectedError, err)
+
+				counts := [4]int64{
+					report.Written(),
+					report.SkippedExisting(),
+					report.SkippedOlder(),
+					report.SkippedWithoutAttribute(),
+				}
+				assert.Equal(st, testCase.expectedCount, counts)
+
+				service.AssertExpectations(st)
+			},
+		)
+	}
+}
+
+func TestParseConflictMode(t *testing.T) {
+	t.Parallel()
+
+	for _, mode := range []string{"overwrite", "skip", "fail", "newer-wins"} {
+		parsed, err := dynamodbcopy.ParseConflictMode(mode)
+		assert.Nil(t, err)
+		assert.Equal(t, dynamodbcopy.ConflictMode(mode), parsed)
+	}
+
+	_, err := dynamodbcopy.ParseConflictMode("invalid")
+	assert.NotNil(t, err)
+}
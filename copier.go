@@ -14,25 +14,54 @@ type copyService struct {
 	srcTable   DynamoDBService
 	trgTable   DynamoDBService
 	copierChan CopierChan
+	segments   int
 	logger     Logger
 }
 
-// NewCopier returns a new Copier to copy records
+// NewCopier returns a new Copier to copy records, scanning the source table with one segment per reader
 func NewCopier(srcTableService, trgTableService DynamoDBService, copierChan CopierChan, logger Logger) Copier {
+	return NewSegmentedCopier(srcTableService, trgTableService, copierChan, 0, logger)
+}
+
+// NewSegmentedCopier returns a new Copier to copy records, scanning the source table in the given number of segments
+// regardless of the number of readers, which scan the segments one after the other.
+// A segments value of 0 scans the table with one segment per reader
+func NewSegmentedCopier(
+	srcTableService, trgTableService DynamoDBService,
+	copierChan CopierChan,
+	segments int,
+	logger Logger,
+) Copier {
 	return copyService{
 		srcTable:   srcTableService,
 		trgTable:   trgTableService,
 		copierChan: copierChan,
+		segments:   segments,
 		logger:     logger,
 	}
 }
 
 // Copy will copy all records from the source to target table.
-// This method will create a worker pool according to the number of readers and writes that are passed as argument
+// This method will create a worker pool according to the number of readers and writes that are passed as argument,
+// the readers pulling the segments to scan from a queue until all the segments are scanned
 func (service copyService) Copy(readers, writers int) error {
-	service.logger.Printf("copying table with %d readers and %d writers", readers, writers)
+	segments := service.segments
+	if segments <= 0 {
+		segments = readers
+	}
+
+	service.logger.Printf("copying table in %d segments with %d readers and %d writers", segments, readers, writers)
+	if buffer := service.copierChan.buffer; buffer != nil {
+		service.logger.Printf("buffering at most %d bytes of scanned items", buffer.maxBytes)
+	}
 	itemsChan, errChan := service.copierChan.Items, service.copierChan.Errors
 
+	segmentsChan := make(chan int, segments)
+	for segment := 0; segment < segments; segment++ {
+		segmentsChan <- segment
+	}
+	close(segmentsChan)
+
 	wgReaders := &sync.WaitGroup{}
 	wgReaders.Add(readers)
 
@@ -40,11 +69,11 @@ func (service copyService) Copy(readers, writers int) error {
 	wgWriters.Add(writers)
 
 	for i := 0; i < readers; i++ {
-		go service.read(i, readers, wgReaders, itemsChan, errChan)
+		go service.read(i, segments, segmentsChan, wgReaders, itemsChan, errChan)
 	}
 
 	for i := 0; i < writers; i++ {
-		go service.write(wgWriters, itemsChan, errChan)
+		go service.write(i, wgWriters, itemsChan, errChan)
 	}
 
 	go func() {
@@ -59,7 +88,8 @@ func (service copyService) Copy(readers, writers int) error {
 
 func (service copyService) read(
 	readerID int,
-	totalReaders int,
+	totalSegments int,
+	segmentsChan <-chan int,
 	wg *sync.WaitGroup,
 	itemsChan chan<- []DynamoDBItem,
 	errChan chan<- error,
@@ -71,13 +101,58 @@ func (service copyService) read(
 		wg.Done()
 	}()
 
-	err := service.srcTable.Scan(totalReaders, readerID, itemsChan)
-	if err != nil {
-		errChan <- err
+	for segment := range segmentsChan {
+		logWith(
+			service.logger,
+			LevelDebug,
+			Fields{"worker": readerID, "segment": segment},
+			"reader %d scanning segment %d of %d",
+			readerID,
+			segment,
+			totalSegments,
+		)
+
+		if err := service.scan(totalSegments, segment, itemsChan); err != nil {
+			errChan <- err
+
+			return
+		}
 	}
 }
 
-func (service copyService) write(wg *sync.WaitGroup, itemsChan <-chan []DynamoDBItem, errChan chan<- error) {
+// scan scans a segment of the source table into the items chan. When the CopierChan bounds the bytes of the
+// buffered items, the scan is blocked while the buffer is full
+func (service copyService) scan(totalSegments, segment int, itemsChan chan<- []DynamoDBItem) error {
+	buffer := service.copierChan.buffer
+	if buffer == nil {
+		return service.srcTable.Scan(totalSegments, segment, itemsChan)
+	}
+
+	pagesChan := make(chan []DynamoDBItem)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		for items := range pagesChan {
+			buffer.acquire(pageSizeBytes(items))
+			itemsChan <- items
+		}
+	}()
+
+	defer func() {
+		close(pagesChan)
+		<-done
+	}()
+
+	return service.srcTable.Scan(totalSegments, segment, pagesChan)
+}
+
+func (service copyService) write(
+	writerID int,
+	wg *sync.WaitGroup,
+	itemsChan <-chan []DynamoDBItem,
+	errChan chan<- error,
+) {
 	defer func() {
 		if err := recover(); err != nil {
 			errChan <- fmt.Errorf("write recovery: %s", err)
@@ -87,26 +162,85 @@ func (service copyService) write(wg *sync.WaitGroup, itemsChan <-chan []DynamoDB
 
 	totalWritten := 0
 	for items := range itemsChan {
-		if err := service.trgTable.BatchWrite(items); err != nil {
+		err := service.trgTable.BatchWrite(items)
+		if buffer := service.copierChan.buffer; buffer != nil {
+			buffer.release(pageSizeBytes(items))
+		}
+
+		if err != nil {
 			errChan <- err
 		}
 
 		totalWritten += len(items)
 	}
 
-	service.logger.Printf("writer wrote a total of %d items", totalWritten)
+	logWith(service.logger, LevelDebug, Fields{"worker": writerID}, "writer wrote a total of %d items", totalWritten)
 }
 
 // CopierChan encapsulates the value and error channel used by the copier
 type CopierChan struct {
 	Items  chan []DynamoDBItem
 	Errors chan error
+	buffer *itemsBuffer
 }
 
 // NewCopierChan creates a new CopierChan with a buffered chan []DynamoDBItem of itemsChanSize
 func NewCopierChan(itemsChanSize int) CopierChan {
-	return CopierChan{
+	return NewBoundedCopierChan(itemsChanSize, 0)
+}
+
+// NewBoundedCopierChan creates a new CopierChan like NewCopierChan that also bounds the estimated bytes of the items
+// scanned and not written yet, blocking the scans while they exceed maxBufferBytes.
+// A page larger than maxBufferBytes is only let through once every buffered page is written.
+// A maxBufferBytes of 0 doesn't bound the bytes
+func NewBoundedCopierChan(itemsChanSize int, maxBufferBytes int64) CopierChan {
+	copierChan := CopierChan{
 		Items:  make(chan []DynamoDBItem, itemsChanSize),
 		Errors: make(chan error),
 	}
+
+	if maxBufferBytes > 0 {
+		copierChan.buffer = &itemsBuffer{
+			maxBytes: maxBufferBytes,
+			cond:     sync.NewCond(&sync.Mutex{}),
+		}
+	}
+
+	return copierChan
+}
+
+// itemsBuffer keeps track of the estimated bytes of the items scanned and not written yet
+type itemsBuffer struct {
+	maxBytes int64
+	bytes    int64
+	cond     *sync.Cond
+}
+
+// acquire waits until there is room in the buffer for the given bytes, or the buffer is empty, and adds them to it
+func (b *itemsBuffer) acquire(size int64) {
+	b.cond.L.Lock()
+	defer b.cond.L.Unlock()
+
+	for b.bytes > 0 && b.bytes+size > b.maxBytes {
+		b.cond.Wait()
+	}
+	b.bytes += size
+}
+
+// release removes the given bytes from the buffer, waking up the scans waiting for room in it
+func (b *itemsBuffer) release(size int64) {
+	b.cond.L.Lock()
+	b.bytes -= size
+	b.cond.L.Unlock()
+
+	b.cond.Broadcast()
+}
+
+func pageSizeBytes(items []DynamoDBItem) int64 {
+	var size int64
+	for _, item := range items {
+		size += ItemSizeBytes(item)
+	}
+
+	return size
 }
@@ -6,6 +6,7 @@ import (
 	"log"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
@@ -151,6 +152,121 @@ func TestCopy(t *testing.T) {
 	}
 }
 
+func TestCopySegments(t *testing.T) {
+	t.Parallel()
+
+	scanError := errors.New("scanError")
+
+	testCases := []struct {
+		subTestName    string
+		totalSegments  int
+		totalReaders   int
+		expectedScans  int
+		failingSegment int
+		expectedError  error
+	}{
+		{"MoreSegmentsThanReaders", 5, 2, 5, -1, nil},
+		{"FewerSegmentsThanReaders", 2, 4, 2, -1, nil},
+		{"DefaultSegments", 0, 3, 3, -1, nil},
+		{"ScanError", 4, 1, 1, 0, scanError},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(
+			testCase.subTestName,
+			func(st *testing.T) {
+				src := &mocks.DynamoDBService{}
+				trg := &mocks.DynamoDBService{}
+
+				copierChans := dynamodbcopy.NewCopierChan(1)
+				var readChan chan<- []dynamodbcopy.DynamoDBItem = copierChans.Items
+
+				totalSegments := testCase.totalSegments
+				if totalSegments == 0 {
+					totalSegments = testCase.totalReaders
+				}
+				for segment := 0; segment < testCase.expectedScans; segment++ {
+					var err error
+					if segment == testCase.failingSegment {
+						err = scanError
+					}
+					src.On("Scan", totalSegments, segment, readChan).Return(err).Once()
+				}
+
+				service := dynamodbcopy.NewSegmentedCopier(
+					src,
+					trg,
+					copierChans,
+					testCase.totalSegments,
+					log.New(ioutil.Discard, "", log.Ltime),
+				)
+
+				err := service.Copy(testCase.totalReaders, 1)
+
+				assert.Equal(st, testCase.expectedError, err)
+				src.AssertExpectations(st)
+			},
+		)
+	}
+}
+
+func TestCopyBoundedBuffer(t *testing.T) {
+	t.Parallel()
+
+	pages := [][]dynamodbcopy.DynamoDBItem{buildItems(3), buildItems(3), buildItems(3), buildItems(3)}
+	pageBytes := int64(0)
+	for _, item := range pages[0] {
+		pageBytes += dynamodbcopy.ItemSizeBytes(item)
+	}
+
+	testCases := []struct {
+		subTestName    string
+		maxBufferBytes int64
+	}{
+		{"OnePage", pageBytes},
+		{"PageLargerThanBuffer", 1},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(
+			testCase.subTestName,
+			func(st *testing.T) {
+				src := &mocks.DynamoDBService{}
+				trg := &mocks.DynamoDBService{}
+
+				copierChans := dynamodbcopy.NewBoundedCopierChan(len(pages), testCase.maxBufferBytes)
+
+				src.On("Scan", 1, 0, mock.Anything).Run(func(args mock.Arguments) {
+					itemsChan := args.Get(2).(chan<- []dynamodbcopy.DynamoDBItem)
+					for _, page := range pages {
+						itemsChan <- page
+					}
+				}).Return(nil).Once()
+
+				var buffered int
+				trg.On("BatchWrite", mock.Anything).Run(func(args mock.Arguments) {
+					time.Sleep(5 * time.Millisecond)
+					if len(copierChans.Items) > buffered {
+						buffered = len(copierChans.Items)
+					}
+				}).Return(nil).Times(len(pages))
+
+				service := dynamodbcopy.NewCopier(src, trg, copierChans, log.New(ioutil.Discard, "", log.Ltime))
+
+				err := service.Copy(1, 1)
+
+				assert.Nil(st, err)
+				assert.Equal(st, 0, buffered, "scans should wait for the buffered pages to be written")
+
+				src.AssertExpectations(st)
+				trg.AssertExpectations(st)
+			},
+		)
+	}
+}
+
 func buildItems(numItems int) []dynamodbcopy.DynamoDBItem {
 	items := make([]dynamodbcopy.DynamoDBItem, numItems)
 
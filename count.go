@@ -0,0 +1,378 @@
+package dynamodbcopy
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+// sizeBuckets are the upper bounds (exclusive) of the item size histogram of a Profile, the last bucket holding
+// the items up to the DynamoDB item size limit of 400KB
+var sizeBuckets = []int64{1 << 10, 4 << 10, 16 << 10, 64 << 10, 256 << 10, 400<<10 + 1}
+
+// Counter is the interface that allows you to count and profile the items of a table
+type Counter interface {
+	Count(readers int) (int64, error)
+	Profile(readers, writers int) (*Profile, error)
+}
+
+type countService struct {
+	table      DynamoDBService
+	options    ScanOptions
+	largest    int
+	copierChan CopierChan
+	logger     Logger
+}
+
+// NewCounter returns a new Counter of the items of the given table that match the given ScanOptions.
+// The profiles of the items report the given number of largest items
+func NewCounter(
+	tableService DynamoDBService,
+	options ScanOptions,
+	largest int,
+	copierChan CopierChan,
+	logger Logger,
+) Counter {
+	return countService{
+		table:      tableService,
+		options:    options,
+		largest:    largest,
+		copierChan: copierChan,
+		logger:     logger,
+	}
+}
+
+// Count counts the items with a parallel scan of the given number of readers, without reading the items
+func (service countService) Count(readers int) (int64, error) {
+	service.logger.Printf("counting items with %d readers", readers)
+
+	type result struct {
+		count int64
+		err   error
+	}
+
+	results := make(chan result, readers)
+	for i := 0; i < readers; i++ {
+		go func(segment int) {
+			count, err := service.table.Count(service.options, readers, segment)
+			results <- result{count, err}
+		}(i)
+	}
+
+	var total int64
+	var err error
+	for i := 0; i < readers; i++ {
+		r := <-results
+		if r.err != nil && err == nil {
+			err = r.err
+		}
+		total += r.count
+	}
+
+	return total, err
+}
+
+// Profile reads the items with a parallel scan, reusing the Copier's worker pool: the readers scan the items
+// while the writers add them to the Profile
+func (service countService) Profile(readers, writers int) (*Profile, error) {
+	description, err := service.table.DescribeTable()
+	if err != nil {
+		return nil, err
+	}
+
+	service.logger.Printf("profiling items with %d readers and %d writers", readers, writers)
+
+	profile := NewProfile(keyNames(description), service.largest)
+	copier := NewCopier(
+		filteringService{service.table, ItemFilter{ScanOptions: service.options}},
+		profilingService{service.table, profile, &sync.Mutex{}},
+		service.copierChan,
+		service.logger,
+	)
+
+	if err := copier.Copy(readers, writers); err != nil {
+		return nil, err
+	}
+
+	return profile, nil
+}
+
+type profilingService struct {
+	DynamoDBService
+	profile *Profile
+	mutex   *sync.Mutex
+}
+
+// BatchWrite adds the given items to the Profile instead of writing them
+func (s profilingService) BatchWrite(items []DynamoDBItem) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, item := range items {
+		s.profile.Add(item)
+	}
+
+	return nil
+}
+
+// Profile holds the statistics of the items of a table: item count and sizes, largest items and
+// the frequency and types of each attribute
+type Profile struct {
+	Items         int64
+	TotalSize     int64
+	SizeHistogram []SizeBucket
+	Largest       []ItemSize
+	Attributes    map[string]*AttributeProfile
+
+	keyNames     []string
+	largestCount int
+}
+
+// SizeBucket counts the items with a size lower than UpperBound (and greater or equal than the previous bucket's)
+type SizeBucket struct {
+	UpperBound int64
+	Items      int64
+}
+
+// ItemSize holds the key and the size of an item
+type ItemSize struct {
+	Key  DynamoDBItem
+	Size int64
+}
+
+// AttributeProfile holds the number of items with an attribute and how many of those hold each type (S, N, M, ...)
+type AttributeProfile struct {
+	Items int64
+	Types map[string]int64
+}
+
+// NewProfile returns an empty Profile of the items of a table with the given key attribute names,
+// keeping track of the given number of largest items, none when it's negative
+func NewProfile(keyNames []string, largest int) *Profile {
+	if largest < 0 {
+		largest = 0
+	}
+
+	histogram := make([]SizeBucket, len(sizeBuckets))
+	for i, upperBound := range sizeBuckets {
+		histogram[i].UpperBound = upperBound
+	}
+
+	return &Profile{
+		SizeHistogram: histogram,
+		Attributes:    make(map[string]*AttributeProfile),
+		keyNames:      keyNames,
+		largestCount:  largest,
+	}
+}
+
+// Add adds an item to the Profile. It's not safe for concurrent use
+func (p *Profile) Add(item DynamoDBItem) {
+	size := ItemSizeBytes(item)
+
+	p.Items++
+	p.TotalSize += size
+
+	bucket := sort.Search(len(p.SizeHistogram), func(i int) bool {
+		return size < p.SizeHistogram[i].UpperBound
+	})
+	if bucket == len(p.SizeHistogram) {
+		bucket--
+	}
+	p.SizeHistogram[bucket].Items++
+
+	p.addLargest(item, size)
+
+	for name, value := range item {
+		attribute, ok := p.Attributes[name]
+		if !ok {
+			attribute = &AttributeProfile{Types: make(map[string]int64)}
+			p.Attributes[name] = attribute
+		}
+
+		attribute.Items++
+		attribute.Types[attributeType(value)]++
+	}
+}
+
+// addLargest keeps the largest items sorted by descending size
+func (p *Profile) addLargest(item DynamoDBItem, size int64) {
+	if len(p.Largest) == p.largestCount && (p.largestCount == 0 || size <= p.Largest[len(p.Largest)-1].Size) {
+		return
+	}
+
+	i := sort.Search(len(p.Largest), func(i int) bool {
+		return p.Largest[i].Size < size
+	})
+
+	p.Largest = append(p.Largest, ItemSize{})
+	copy(p.Largest[i+1:], p.Largest[i:])
+	p.Largest[i] = ItemSize{Key: itemKey(item, p.keyNames), Size: size}
+
+	if len(p.Largest) > p.largestCount {
+		p.Largest = p.Largest[:p.largestCount]
+	}
+}
+
+// Print logs the Profile
+func (p *Profile) Print(logger Logger) {
+	var average int64
+	if p.Items > 0 {
+		average = p.TotalSize / p.Items
+	}
+	logger.Printf("items: %d, total size: %d bytes, average size: %d bytes", p.Items, p.TotalSize, average)
+
+	logger.Printf("item size histogram:")
+	lowerBound := int64(0)
+	for _, bucket := range p.SizeHistogram {
+		logger.Printf("  %6s - %6s: %d", formatBytes(lowerBound), formatBytes(bucket.UpperBound), bucket.Items)
+		lowerBound = bucket.UpperBound
+	}
+
+	logger.Printf("largest items:")
+	for _, largest := range p.Largest {
+		logger.Printf("  %s: %d bytes", formatItemKey(largest.Key), largest.Size)
+	}
+
+	names := make([]string, 0, len(p.Attributes))
+	for name := range p.Attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	logger.Printf("attributes:")
+	for _, name := range names {
+		attribute := p.Attributes[name]
+
+		types := make([]string, 0, len(attribute.Types))
+		for attributeType, count := range attribute.Types {
+			types = append(types, fmt.Sprintf("%s: %d", attributeType, count))
+		}
+		sort.Strings(types)
+
+		logger.Printf(
+			"  %s: %d items (%.1f%%), types: %s",
+			name,
+			attribute.Items,
+			float64(attribute.Items)*100/float64(p.Items),
+			strings.Join(types, ", "),
+		)
+	}
+}
+
+// ItemSizeBytes estimates the size of an item the way DynamoDB does:
+// the lengths of the attribute names plus the sizes of the attribute values
+func ItemSizeBytes(item DynamoDBItem) int64 {
+	var size int64
+	for name, value := range item {
+		size += int64(len(name)) + attributeValueSize(value)
+	}
+
+	return size
+}
+
+func attributeValueSize(value *dynamodb.AttributeValue) int64 {
+	switch {
+	case value.S != nil:
+		return int64(len(*value.S))
+	case value.N != nil:
+		return numberSize(*value.N)
+	case value.B != nil:
+		return int64(len(value.B))
+	case value.BOOL != nil, value.NULL != nil:
+		return 1
+	case value.SS != nil:
+		var size int64
+		for _, s := range value.SS {
+			size += int64(len(*s))
+		}
+
+		return size
+	case value.NS != nil:
+		var size int64
+		for _, n := range value.NS {
+			size += numberSize(*n)
+		}
+
+		return size
+	case value.BS != nil:
+		var size int64
+		for _, b := range value.BS {
+			size += int64(len(b))
+		}
+
+		return size
+	case value.L != nil:
+		size := int64(3)
+		for _, element := range value.L {
+			size += 1 + attributeValueSize(element)
+		}
+
+		return size
+	default:
+		size := int64(3)
+		for name, element := range value.M {
+			size += 1 + int64(len(name)) + attributeValueSize(element)
+		}
+
+		return size
+	}
+}
+
+// numberSize approximates the size of a number: one byte per two significant digits plus one byte
+func numberSize(number string) int64 {
+	digits := strings.TrimLeft(strings.TrimLeft(number, "-+"), "0.")
+
+	return int64((len(digits)+1)/2 + 1)
+}
+
+func attributeType(value *dynamodb.AttributeValue) string {
+	switch {
+	case value.S != nil:
+		return "S"
+	case value.N != nil:
+		return "N"
+	case value.B != nil:
+		return "B"
+	case value.BOOL != nil:
+		return "BOOL"
+	case value.NULL != nil:
+		return "NULL"
+	case value.SS != nil:
+		return "SS"
+	case value.NS != nil:
+		return "NS"
+	case value.BS != nil:
+		return "BS"
+	case value.L != nil:
+		return "L"
+	default:
+		return "M"
+	}
+}
+
+func formatItemKey(key DynamoDBItem) string {
+	names := make([]string, 0, len(key))
+	for name := range key {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	parts := make([]string, len(names))
+	for i, name := range names {
+		parts[i] = fmt.Sprintf("%s=%s", name, formatAttributeValue(key[name]))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+func formatBytes(bytes int64) string {
+	if bytes < 1<<10 {
+		return fmt.Sprintf("%dB", bytes)
+	}
+
+	return fmt.Sprintf("%dKB", bytes>>10)
+}
@@ -0,0 +1,187 @@
+package dynamodbcopy_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/uniplaces/dynamodbcopy"
+	"github.com/uniplaces/dynamodbcopy/mocks"
+)
+
+func TestCounterCount(t *testing.T) {
+	t.Parallel()
+
+	options := dynamodbcopy.ScanOptions{FilterExpression: "attribute_exists(expiresAt)"}
+	expectedError := errors.New("count error")
+
+	testCases := []struct {
+		subTestName   string
+		mocker        func(service *mocks.DynamoDBService)
+		expectedCount int64
+		expectedError bool
+	}{
+		{
+			"CountError",
+			func(service *mocks.DynamoDBService) {
+				service.On("Count", options, 2, 0).Return(int64(3), nil).Once()
+				service.On("Count", options, 2, 1).Return(int64(0), expectedError).Once()
+			},
+			3,
+			true,
+		},
+		{
+			"Success",
+			func(service *mocks.DynamoDBService) {
+				service.On("Count", options, 2, 0).Return(int64(3), nil).Once()
+				service.On("Count", options, 2, 1).Return(int64(4), nil).Once()
+			},
+			7,
+			false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.subTestName,
+			func(st *testing.T) {
+				service := &mocks.DynamoDBService{}
+				testCase.mocker(service)
+
+				counter := dynamodbcopy.NewCounter(
+					service,
+					options,
+					10,
+					dynamodbcopy.NewCopierChan(1),
+					log.New(ioutil.Discard, "", log.LstdFlags),
+				)
+
+				count, err := counter.Count(2)
+
+				assert.Equal(st, testCase.expectedError, err != nil)
+				assert.Equal(st, testCase.expectedCount, count)
+
+				service.AssertExpectations(st)
+			},
+		)
+	}
+}
+
+func TestCounterProfile(t *testing.T) {
+	t.Parallel()
+
+	description := buildKeyedTableDescription(expectedTableName, "id", dynamodb.ScalarAttributeTypeS)
+	small := dynamodbcopy.DynamoDBItem{"id": {S: aws.String("1")}, "size": {N: aws.String("1")}}
+	large := dynamodbcopy.DynamoDBItem{
+		"id":   {S: aws.String("2")},
+		"size": {S: aws.String(string(make([]byte, 2000)))},
+	}
+
+	service := &mocks.DynamoDBService{}
+	service.On("DescribeTable").Return(&description, nil).Once()
+	service.On("ScanWithOptions", dynamodbcopy.ScanOptions{}, 1, 0, mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(3).(chan<- []dynamodbcopy.DynamoDBItem) <- []dynamodbcopy.DynamoDBItem{small, large}
+		}).
+		Return(nil).
+		Once()
+
+	counter := dynamodbcopy.NewCounter(
+		service,
+		dynamodbcopy.ScanOptions{},
+		1,
+		dynamodbcopy.NewCopierChan(1),
+		log.New(ioutil.Discard, "", log.LstdFlags),
+	)
+
+	profile, err := counter.Profile(1, 1)
+
+	require.Nil(t, err)
+	assert.Equal(t, int64(2), profile.Items)
+	assert.Equal(t, int64(9+2007), profile.TotalSize)
+	assert.Equal(t, int64(1), profile.SizeHistogram[0].Items)
+	assert.Equal(t, int64(1), profile.SizeHistogram[1].Items)
+	assert.Equal(
+		t,
+		[]dynamodbcopy.ItemSize{{Key: dynamodbcopy.DynamoDBItem{"id": {S: aws.String("2")}}, Size: 2007}},
+		profile.Largest,
+	)
+	assert.Equal(t, &dynamodbcopy.AttributeProfile{Items: 2, Types: map[string]int64{"S": 2}}, profile.Attributes["id"])
+	assert.Equal(
+		t,
+		&dynamodbcopy.AttributeProfile{Items: 2, Types: map[string]int64{"S": 1, "N": 1}},
+		profile.Attributes["size"],
+	)
+
+	profile.Print(log.New(ioutil.Discard, "", log.LstdFlags))
+
+	service.AssertExpectations(t)
+}
+
+func TestProfileLargest(t *testing.T) {
+	t.Parallel()
+
+	profile := dynamodbcopy.NewProfile([]string{"id"}, 2)
+	for _, value := range []string{"aa", "aaaa", "a", "aaa"} {
+		profile.Add(dynamodbcopy.DynamoDBItem{"id": {S: aws.String(value)}})
+	}
+
+	assert.Equal(
+		t,
+		[]dynamodbcopy.ItemSize{
+			{Key: dynamodbcopy.DynamoDBItem{"id": {S: aws.String("aaaa")}}, Size: 6},
+			{Key: dynamodbcopy.DynamoDBItem{"id": {S: aws.String("aaa")}}, Size: 5},
+		},
+		profile.Largest,
+	)
+}
+
+func TestProfileNegativeLargest(t *testing.T) {
+	t.Parallel()
+
+	profile := dynamodbcopy.NewProfile([]string{"id"}, -1)
+	profile.Add(dynamodbcopy.DynamoDBItem{"id": {S: aws.String("a")}})
+
+	assert.Empty(t, profile.Largest)
+}
+
+func TestItemSizeBytes(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		subTestName  string
+		item         dynamodbcopy.DynamoDBItem
+		expectedSize int64
+	}{
+		{"String", dynamodbcopy.DynamoDBItem{"name": {S: aws.String("value")}}, 9},
+		{"Number", dynamodbcopy.DynamoDBItem{"n": {N: aws.String("-123.45")}}, 5},
+		{"Binary", dynamodbcopy.DynamoDBItem{"b": {B: []byte{1, 2, 3}}}, 4},
+		{"Bool", dynamodbcopy.DynamoDBItem{"flag": {BOOL: aws.Bool(true)}}, 5},
+		{"StringSet", dynamodbcopy.DynamoDBItem{"ss": {SS: aws.StringSlice([]string{"a", "bc"})}}, 5},
+		{
+			"List",
+			dynamodbcopy.DynamoDBItem{"l": {L: []*dynamodb.AttributeValue{{S: aws.String("ab")}, {NULL: aws.Bool(true)}}}},
+			9,
+		},
+		{
+			"Map",
+			dynamodbcopy.DynamoDBItem{"m": {M: map[string]*dynamodb.AttributeValue{"k": {S: aws.String("ab")}}}},
+			8,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.subTestName,
+			func(st *testing.T) {
+				assert.Equal(st, testCase.expectedSize, dynamodbcopy.ItemSizeBytes(testCase.item))
+			},
+		)
+	}
+}
@@ -0,0 +1,86 @@
+package dynamodbcopy
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+const (
+	// DefaultSessionDuration is the default duration of the sessions of the assumed roles
+	DefaultSessionDuration = 15 * time.Minute
+
+	// credentialsExpiryWindow is how long before they expire the credentials of an assumed role are refreshed
+	credentialsExpiryWindow = time.Minute
+)
+
+// CredentialsRefresher is implemented by the DynamoDB clients able to refresh their credentials, e.g. once they expired
+type CredentialsRefresher interface {
+	RefreshCredentials()
+}
+
+type refreshingDynamoClient struct {
+	DynamoDBClient
+	credentials *credentials.Credentials
+}
+
+// NewRefreshingDynamoClient creates a DynamoDB client like NewDynamoClient, assuming the provided ARN role (if any)
+// for sessions of the given duration.
+//
+// The credentials of the role are refreshed shortly before they expire, logging every refresh, and the client is a
+// CredentialsRefresher, so that a DynamoDBService can refresh them and retry the requests failing with expired
+// credentials
+func NewRefreshingDynamoClient(roleArn string, sessionDuration time.Duration, logger Logger) DynamoDBClient {
+	currentSession := newSession("")
+	if roleArn != "" {
+		provider := &stscreds.AssumeRoleProvider{
+			Client:       sts.New(currentSession),
+			RoleARN:      roleArn,
+			Duration:     sessionDuration,
+			ExpiryWindow: credentialsExpiryWindow,
+		}
+		roleCredentials := credentials.NewCredentials(loggingRoleProvider{provider, logger})
+
+		currentSession = currentSession.Copy(&aws.Config{Credentials: roleCredentials})
+	}
+
+	return refreshingDynamoClient{
+		DynamoDBClient: dynamodb.New(currentSession),
+		credentials:    currentSession.Config.Credentials,
+	}
+}
+
+// RefreshCredentials expires the credentials of the client, so they're retrieved again by its next request
+func (c refreshingDynamoClient) RefreshCredentials() {
+	c.credentials.Expire()
+}
+
+type loggingRoleProvider struct {
+	*stscreds.AssumeRoleProvider
+	logger Logger
+}
+
+// Retrieve assumes the role, logging the expiration of the retrieved credentials
+func (p loggingRoleProvider) Retrieve() (credentials.Value, error) {
+	value, err := p.AssumeRoleProvider.Retrieve()
+	if err != nil {
+		logWith(p.logger, LevelError, Fields{"role": p.RoleARN}, "unable to assume role %s: %s", p.RoleARN, err)
+
+		return value, err
+	}
+
+	logWith(
+		p.logger,
+		LevelInfo,
+		Fields{"role": p.RoleARN},
+		"retrieved credentials of role %s, refreshed at %s",
+		p.RoleARN,
+		p.ExpiresAt().Format(time.RFC3339),
+	)
+
+	return value, nil
+}
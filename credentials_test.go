@@ -0,0 +1,87 @@
+package dynamodbcopy_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/uniplaces/dynamodbcopy"
+	"github.com/uniplaces/dynamodbcopy/mocks"
+)
+
+type refreshingClientMock struct {
+	*mocks.DynamoDBAPI
+	refreshed int
+}
+
+func (c *refreshingClientMock) RefreshCredentials() {
+	c.refreshed++
+}
+
+func TestRefreshCredentials(t *testing.T) {
+	t.Parallel()
+
+	batchInput := buildBatchWriteItemInput(10)
+	expiredErr := awserr.New("ExpiredTokenException", "token expired", errors.New("expired"))
+
+	testCases := []struct {
+		subTestName       string
+		refresher         bool
+		expectedRefreshed int
+		errorExpected     bool
+	}{
+		{"Refresher", true, 1, false},
+		{"NotRefresher", false, 0, true},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(
+			testCase.subTestName,
+			func(st *testing.T) {
+				api := &mocks.DynamoDBAPI{}
+				api.On("BatchWriteItem", &batchInput).Return(nil, expiredErr).Once()
+
+				client := &refreshingClientMock{DynamoDBAPI: api}
+				var dynamoClient dynamodbcopy.DynamoDBClient = api
+				if testCase.refresher {
+					api.On("BatchWriteItem", &batchInput).Return(&dynamodb.BatchWriteItemOutput{}, nil).Once()
+					dynamoClient = client
+				}
+
+				service := dynamodbcopy.NewDynamoDBService(
+					expectedTableName,
+					dynamoClient,
+					testSleeper,
+					log.New(ioutil.Discard, "", log.Ltime),
+				)
+
+				err := service.BatchWrite(getItems(batchInput))
+
+				assertExpectedError(st, testCase.errorExpected, err)
+				assert.Equal(st, testCase.expectedRefreshed, client.refreshed)
+
+				api.AssertExpectations(st)
+			},
+		)
+	}
+}
+
+func TestNewRefreshingDynamoClient(t *testing.T) {
+	t.Parallel()
+
+	client := dynamodbcopy.NewRefreshingDynamoClient(
+		"arn:aws:iam::123456789012:role/copy",
+		dynamodbcopy.DefaultSessionDuration,
+		log.New(ioutil.Discard, "", log.Ltime),
+	)
+
+	refresher, ok := client.(dynamodbcopy.CredentialsRefresher)
+
+	assert.True(t, ok)
+	assert.NotPanics(t, refresher.RefreshCredentials)
+}
@@ -0,0 +1,226 @@
+package dynamodbcopy
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+// ItemFilter abstracts the items to read from a table: the items matching KeyCondition when it's set (query),
+// or all the items otherwise (scan), filtered by the ScanOptions
+type ItemFilter struct {
+	KeyCondition string
+	ScanOptions
+}
+
+// ItemDeleter is the interface that allows you to delete the items of a table that match an ItemFilter
+type ItemDeleter interface {
+	Delete(readers, writers int) (int64, error)
+}
+
+type deleteItemsService struct {
+	table      DynamoDBService
+	filter     ItemFilter
+	backup     io.Writer
+	dryRun     bool
+	copierChan CopierChan
+	logger     Logger
+}
+
+// NewItemDeleter returns a new ItemDeleter that deletes the items of the given table that match filter.
+//
+// When backup is not nil, each item is written into it as a line of DynamoDB JSON (NDJSON) before being deleted.
+// With dryRun, the matching items are only counted (and backed up), without being deleted
+func NewItemDeleter(
+	tableService DynamoDBService,
+	filter ItemFilter,
+	backup io.Writer,
+	dryRun bool,
+	copierChan CopierChan,
+	logger Logger,
+) ItemDeleter {
+	return deleteItemsService{
+		table:      tableService,
+		filter:     filter,
+		backup:     backup,
+		dryRun:     dryRun,
+		copierChan: copierChan,
+		logger:     logger,
+	}
+}
+
+// Delete deletes the matching items, returning how many were matched, reusing the Copier's worker pool:
+// the readers perform a parallel scan (or a single query) while the writers back up and delete the items in batches.
+// Without a backup, only the key attributes of the items are read
+func (service deleteItemsService) Delete(readers, writers int) (int64, error) {
+	description, err := service.table.DescribeTable()
+	if err != nil {
+		return 0, err
+	}
+	names := keyNames(description)
+
+	filter := service.filter
+	if service.backup == nil {
+		filter.ProjectionAttributes = names
+	}
+
+	service.logger.Printf("deleting items with %d readers and %d writers (dry run: %t)", readers, writers, service.dryRun)
+
+	writer := &itemDeletingService{
+		DynamoDBService: service.table,
+		keyNames:        names,
+		dryRun:          service.dryRun,
+		mutex:           &sync.Mutex{},
+	}
+	if service.backup != nil {
+		writer.backup = json.NewEncoder(service.backup)
+	}
+
+	copier := NewCopier(
+		filteringService{service.table, filter},
+		writer,
+		service.copierChan,
+		service.logger,
+	)
+	err = copier.Copy(readers, writers)
+
+	return atomic.LoadInt64(&writer.matched), err
+}
+
+type filteringService struct {
+	DynamoDBService
+	filter ItemFilter
+}
+
+// Scan reads the items of the wrapped DynamoDBService matching the ItemFilter.
+// Queries can't be performed in parallel, so only the first segment performs the query
+func (s filteringService) Scan(totalSegments, segment int, itemsChan chan<- []DynamoDBItem) error {
+	if s.filter.KeyCondition == "" {
+		return s.ScanWithOptions(s.filter.ScanOptions, totalSegments, segment, itemsChan)
+	}
+
+	if segment != 0 {
+		return nil
+	}
+
+	return s.Query(s.filter.KeyCondition, s.filter.ScanOptions, itemsChan)
+}
+
+type itemDeletingService struct {
+	DynamoDBService
+	keyNames []string
+	dryRun   bool
+	matched  int64
+	mutex    *sync.Mutex
+	backup   *json.Encoder
+}
+
+// BatchWrite backs up and deletes the given items from the wrapped DynamoDBService
+func (s *itemDeletingService) BatchWrite(items []DynamoDBItem) error {
+	if err := s.backUp(items); err != nil {
+		return err
+	}
+
+	if !s.dryRun {
+		keys := make([]DynamoDBItem, len(items))
+		for i, item := range items {
+			keys[i] = itemKey(item, s.keyNames)
+		}
+
+		if err := s.BatchDelete(keys); err != nil {
+			return err
+		}
+	}
+	atomic.AddInt64(&s.matched, int64(len(items)))
+
+	return nil
+}
+
+func (s *itemDeletingService) backUp(items []DynamoDBItem) error {
+	if s.backup == nil {
+		return nil
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, item := range items {
+		if err := s.backup.Encode(itemJSON(item)); err != nil {
+			return fmt.Errorf("unable to back up item: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// itemJSON returns the DynamoDB JSON representation of an item, e.g. {"id": {"S": "1"}}
+func itemJSON(item map[string]*dynamodb.AttributeValue) map[string]interface{} {
+	values := make(map[string]interface{}, len(item))
+	for name, value := range item {
+		values[name] = attributeValueJSON(value)
+	}
+
+	return values
+}
+
+func attributeValueJSON(value *dynamodb.AttributeValue) map[string]interface{} {
+	switch {
+	case value.S != nil:
+		return map[string]interface{}{"S": *value.S}
+	case value.N != nil:
+		return map[string]interface{}{"N": *value.N}
+	case value.B != nil:
+		return map[string]interface{}{"B": value.B}
+	case value.BOOL != nil:
+		return map[string]interface{}{"BOOL": *value.BOOL}
+	case value.NULL != nil:
+		return map[string]interface{}{"NULL": *value.NULL}
+	case value.SS != nil:
+		return map[string]interface{}{"SS": value.SS}
+	case value.NS != nil:
+		return map[string]interface{}{"NS": value.NS}
+	case value.BS != nil:
+		return map[string]interface{}{"BS": value.BS}
+	case value.L != nil:
+		list := make([]interface{}, len(value.L))
+		for i, element := range value.L {
+			list[i] = attributeValueJSON(element)
+		}
+
+		return map[string]interface{}{"L": list}
+	default:
+		return map[string]interface{}{"M": itemJSON(value.M)}
+	}
+}
+
+// ParseAttributeValues parses expression attribute values in DynamoDB JSON, e.g. {":now": {"N": "1546300800"}}
+func ParseAttributeValues(value string) (map[string]*dynamodb.AttributeValue, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	var values map[string]*dynamodb.AttributeValue
+	if err := json.Unmarshal([]byte(value), &values); err != nil {
+		return nil, fmt.Errorf("invalid attribute values %q: %s", value, err)
+	}
+
+	return values, nil
+}
+
+// ParseAttributeNames parses expression attribute names in JSON, e.g. {"#tenant": "tenant"}
+func ParseAttributeNames(value string) (map[string]*string, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	var names map[string]*string
+	if err := json.Unmarshal([]byte(value), &names); err != nil {
+		return nil, fmt.Errorf("invalid attribute names %q: %s", value, err)
+	}
+
+	return names, nil
+}
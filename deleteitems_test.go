@@ -0,0 +1,241 @@
+package dynamodbcopy_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/uniplaces/dynamodbcopy"
+	"github.com/uniplaces/dynamodbcopy/mocks"
+)
+
+func TestDeleteItems(t *testing.T) {
+	t.Parallel()
+
+	description := buildKeyedTableDescription(expectedTableName, "id", dynamodb.ScalarAttributeTypeS)
+	filter := dynamodbcopy.ItemFilter{
+		ScanOptions: dynamodbcopy.ScanOptions{
+			FilterExpression:          "expiresAt < :now",
+			ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{":now": {N: aws.String("10")}},
+		},
+	}
+	keysOnly := filter
+	keysOnly.ProjectionAttributes = []string{"id"}
+	queryFilter := dynamodbcopy.ItemFilter{KeyCondition: "id = :id", ScanOptions: filter.ScanOptions}
+	queryKeysOnly := queryFilter
+	queryKeysOnly.ProjectionAttributes = []string{"id"}
+
+	items := []dynamodbcopy.DynamoDBItem{buildMirrorItem("1", "a"), buildMirrorItem("2", "b")}
+	keys := []dynamodbcopy.DynamoDBItem{
+		{"id": {S: aws.String("1")}},
+		{"id": {S: aws.String("2")}},
+	}
+	sendItems := func(index int) func(args mock.Arguments) {
+		return func(args mock.Arguments) {
+			args.Get(index).(chan<- []dynamodbcopy.DynamoDBItem) <- items
+		}
+	}
+
+	expectedError := errors.New("delete items error")
+
+	testCases := []struct {
+		subTestName     string
+		filter          dynamodbcopy.ItemFilter
+		readers         int
+		dryRun          bool
+		mocker          func(service *mocks.DynamoDBService)
+		expectedMatched int64
+		expectedError   bool
+	}{
+		{
+			"DescribeError",
+			filter,
+			2,
+			false,
+			func(service *mocks.DynamoDBService) {
+				service.On("DescribeTable").Return(nil, expectedError).Once()
+			},
+			0,
+			true,
+		},
+		{
+			"DeleteError",
+			filter,
+			1,
+			false,
+			func(service *mocks.DynamoDBService) {
+				service.On("DescribeTable").Return(&description, nil).Once()
+				service.On("ScanWithOptions", keysOnly.ScanOptions, 1, 0, mock.Anything).
+					Run(sendItems(3)).
+					Return(nil).
+					Once()
+				service.On("BatchDelete", keys).Return(expectedError).Once()
+			},
+			0,
+			true,
+		},
+		{
+			"Scan",
+			filter,
+			2,
+			false,
+			func(service *mocks.DynamoDBService) {
+				service.On("DescribeTable").Return(&description, nil).Once()
+				service.On("ScanWithOptions", keysOnly.ScanOptions, 2, mock.Anything, mock.Anything).
+					Run(sendItems(3)).
+					Return(nil).
+					Twice()
+				service.On("BatchDelete", keys).Return(nil).Twice()
+			},
+			4,
+			false,
+		},
+		{
+			"Query",
+			queryFilter,
+			2,
+			false,
+			func(service *mocks.DynamoDBService) {
+				service.On("DescribeTable").Return(&description, nil).Once()
+				service.On("Query", "id = :id", queryKeysOnly.ScanOptions, mock.Anything).
+					Run(sendItems(2)).
+					Return(nil).
+					Once()
+				service.On("BatchDelete", keys).Return(nil).Once()
+			},
+			2,
+			false,
+		},
+		{
+			"DryRun",
+			filter,
+			2,
+			true,
+			func(service *mocks.DynamoDBService) {
+				service.On("DescribeTable").Return(&description, nil).Once()
+				service.On("ScanWithOptions", keysOnly.ScanOptions, 2, mock.Anything, mock.Anything).
+					Run(sendItems(3)).
+					Return(nil).
+					Twice()
+			},
+			4,
+			false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.subTestName,
+			func(st *testing.T) {
+				service := &mocks.DynamoDBService{}
+				testCase.mocker(service)
+
+				deleter := dynamodbcopy.NewItemDeleter(
+					service,
+					testCase.filter,
+					nil,
+					testCase.dryRun,
+					dynamodbcopy.NewCopierChan(2),
+					log.New(ioutil.Discard, "", log.LstdFlags),
+				)
+
+				matched, err := deleter.Delete(testCase.readers, 2)
+
+				assert.Equal(st, testCase.expectedError, err != nil)
+				assert.Equal(st, testCase.expectedMatched, matched)
+
+				service.AssertExpectations(st)
+			},
+		)
+	}
+}
+
+func TestDeleteItemsBackup(t *testing.T) {
+	t.Parallel()
+
+	description := buildKeyedTableDescription(expectedTableName, "id", dynamodb.ScalarAttributeTypeS)
+	item := dynamodbcopy.DynamoDBItem{
+		"id":   {S: aws.String("1")},
+		"size": {N: aws.String("10")},
+		"tags": {L: []*dynamodb.AttributeValue{{BOOL: aws.Bool(true)}, {SS: []*string{aws.String("a")}}}},
+	}
+
+	service := &mocks.DynamoDBService{}
+	service.On("DescribeTable").Return(&description, nil).Once()
+	service.On("ScanWithOptions", dynamodbcopy.ScanOptions{}, 1, 0, mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(3).(chan<- []dynamodbcopy.DynamoDBItem) <- []dynamodbcopy.DynamoDBItem{item}
+		}).
+		Return(nil).
+		Once()
+	service.On("BatchDelete", []dynamodbcopy.DynamoDBItem{{"id": {S: aws.String("1")}}}).Return(nil).Once()
+
+	backup := &bytes.Buffer{}
+	deleter := dynamodbcopy.NewItemDeleter(
+		service,
+		dynamodbcopy.ItemFilter{},
+		backup,
+		false,
+		dynamodbcopy.NewCopierChan(1),
+		log.New(ioutil.Discard, "", log.LstdFlags),
+	)
+
+	matched, err := deleter.Delete(1, 1)
+
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), matched)
+	assert.JSONEq(
+		t,
+		`{"id": {"S": "1"}, "size": {"N": "10"}, "tags": {"L": [{"BOOL": true}, {"SS": ["a"]}]}}`,
+		backup.String(),
+	)
+
+	service.AssertExpectations(t)
+}
+
+func TestParseAttributeValues(t *testing.T) {
+	t.Parallel()
+
+	values, err := dynamodbcopy.ParseAttributeValues(`{":now": {"N": "10"}, ":id": {"S": "1"}}`)
+
+	require.Nil(t, err)
+	assert.Equal(
+		t,
+		map[string]*dynamodb.AttributeValue{":now": {N: aws.String("10")}, ":id": {S: aws.String("1")}},
+		values,
+	)
+
+	values, err = dynamodbcopy.ParseAttributeValues("")
+
+	assert.Nil(t, err)
+	assert.Nil(t, values)
+
+	_, err = dynamodbcopy.ParseAttributeValues("invalid")
+
+	assert.NotNil(t, err)
+}
+
+func TestParseAttributeNames(t *testing.T) {
+	t.Parallel()
+
+	names, err := dynamodbcopy.ParseAttributeNames(`{"#tenant": "tenant"}`)
+
+	require.Nil(t, err)
+	assert.Equal(t, map[string]*string{"#tenant": aws.String("tenant")}, names)
+
+	names, err = dynamodbcopy.ParseAttributeNames("")
+
+	assert.Nil(t, err)
+	assert.Nil(t, names)
+
+	_, err = dynamodbcopy.ParseAttributeNames("invalid")
+
+	assert.NotNil(t, err)
+}
@@ -3,6 +3,9 @@ package dynamodbcopy
 import (
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -11,13 +14,11 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 const (
 	maxBatchWriteSize = 25
-	maxRetryTime      = int(time.Minute) * 3
-
-	errCodeThrottlingException = "ThrottlingException"
 )
 
 // DynamoDBClient is a wrapper interface over aws-sdk dynamodbiface.DynamoDBClient for mocking purposes
@@ -35,6 +36,11 @@ type DynamoDBClient interface {
 // Please refer to https://docs.aws.amazon.com/sdk-for-go/v1/developer-guide/configuring-sdk.html for more
 // information on how you can set up the SDK
 func NewDynamoClient(roleArn string) DynamoDBClient {
+	return dynamodb.New(newSession(roleArn))
+}
+
+// newSession creates a new Session with SharedConfigEnable, assuming the provided ARN role if it isn't empty
+func newSession(roleArn string) *session.Session {
 	options := session.Options{
 		SharedConfigState: session.SharedConfigEnable,
 	}
@@ -43,10 +49,10 @@ func NewDynamoClient(roleArn string) DynamoDBClient {
 	if roleArn != "" {
 		roleCredentials := stscreds.NewCredentials(currentSession, roleArn)
 
-		return dynamodb.New(currentSession, &aws.Config{Credentials: roleCredentials})
+		return currentSession.Copy(&aws.Config{Credentials: roleCredentials})
 	}
 
-	return dynamodb.New(currentSession)
+	return currentSession
 }
 
 // DynamoDBItem type to abstract a DynamoDB item
@@ -56,9 +62,111 @@ type DynamoDBItem map[string]*dynamodb.AttributeValue
 type DynamoDBService interface {
 	DescribeTable() (*dynamodb.TableDescription, error)
 	UpdateCapacity(capacity Capacity) error
+	UpdateProvisioning(capacity *Capacity, indexes map[string]*Capacity) error
+	UpdateBillingMode(capacity *Capacity) error
 	WaitForReadyTable() error
 	BatchWrite(items []DynamoDBItem) error
+	BatchDelete(keys []DynamoDBItem) error
 	Scan(totalSegments, segment int, itemsChan chan<- []DynamoDBItem) error
+	ScanWithOptions(options ScanOptions, totalSegments, segment int, itemsChan chan<- []DynamoDBItem) error
+	Query(keyCondition string, options ScanOptions, itemsChan chan<- []DynamoDBItem) error
+	Count(options ScanOptions, totalSegments, segment int) (int64, error)
+	ConsumedCapacity() ConsumedCapacity
+	IsEmpty() (bool, error)
+	Tags() (map[string]string, error)
+	TimeToLive() (*dynamodb.TimeToLiveDescription, error)
+	PointInTimeRecovery() (bool, error)
+	UpdateTimeToLive(attributeName string, enabled bool) error
+	UpdatePointInTimeRecovery(enabled bool) error
+	ContributorInsights() (bool, error)
+	UpdateContributorInsights(enabled bool) error
+	UpdateDeletionProtection(enabled bool) error
+	UpdateStream(stream *dynamodb.StreamSpecification) error
+	TagTable(tags map[string]string) error
+	ConditionalPut(item DynamoDBItem, condition WriteCondition) (bool, error)
+}
+
+// ScanOptions abstracts the optional parameters of a scan or query
+type ScanOptions struct {
+	// ProjectionAttributes are the names of the attributes to retrieve (all of them if empty)
+	ProjectionAttributes []string
+	// IndexName is the name of the secondary index to read from instead of the table
+	IndexName string
+	// FilterExpression filters the items read, after they are read
+	FilterExpression string
+	// ExpressionAttributeNames are the attribute name placeholders (#name) used in the expressions
+	ExpressionAttributeNames map[string]*string
+	// ExpressionAttributeValues are the attribute value placeholders (:value) used in the expressions
+	ExpressionAttributeValues map[string]*dynamodb.AttributeValue
+}
+
+// expressions returns the projection and filter expressions of the ScanOptions (nil when not set),
+// with the attribute names used by both
+func (o ScanOptions) expressions() (*string, *string, map[string]*string) {
+	var projection, filter *string
+	var names map[string]*string
+	if len(o.ExpressionAttributeNames) > 0 || len(o.ProjectionAttributes) > 0 {
+		names = make(map[string]*string, len(o.ExpressionAttributeNames)+len(o.ProjectionAttributes))
+		for placeholder, name := range o.ExpressionAttributeNames {
+			names[placeholder] = name
+		}
+	}
+
+	if len(o.ProjectionAttributes) > 0 {
+		placeholders := make([]string, len(o.ProjectionAttributes))
+		for i, attribute := range o.ProjectionAttributes {
+			placeholders[i] = fmt.Sprintf("#projection%d", i)
+			names[placeholders[i]] = aws.String(attribute)
+		}
+		projection = aws.String(strings.Join(placeholders, ", "))
+	}
+
+	if o.FilterExpression != "" {
+		filter = aws.String(o.FilterExpression)
+	}
+
+	return projection, filter, names
+}
+
+func (o ScanOptions) indexName() *string {
+	if o.IndexName == "" {
+		return nil
+	}
+
+	return aws.String(o.IndexName)
+}
+
+// WriteCondition abstracts a DynamoDB condition expression, with its attribute names and values
+type WriteCondition struct {
+	Expression string
+	Names      map[string]*string
+	Values     map[string]*dynamodb.AttributeValue
+}
+
+// ConsumedCapacity abstracts the total read and write capacity units consumed by the scans and writes of a table
+type ConsumedCapacity struct {
+	Read  float64
+	Write float64
+}
+
+type consumedCapacityCounter struct {
+	mutex    *sync.Mutex
+	consumed ConsumedCapacity
+}
+
+func (c *consumedCapacityCounter) add(read, write float64) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.consumed.Read += read
+	c.consumed.Write += write
+}
+
+func (c *consumedCapacityCounter) get() ConsumedCapacity {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.consumed
 }
 
 type dynamoDBSerivce struct {
@@ -66,11 +174,74 @@ type dynamoDBSerivce struct {
 	client    DynamoDBClient
 	sleep     Sleeper
 	logger    Logger
+	consumed  *consumedCapacityCounter
+	metrics   *Metrics
+	tracing   *Tracing
+	retries   RetryPolicy
+	waits     RetryPolicy
+}
+
+// DynamoDBServiceOption configures the optional instrumentation and retries of a DynamoDBService
+type DynamoDBServiceOption func(*dynamoDBSerivce)
+
+// WithMetrics records the items read and written, batch write latencies, retries, throttles and consumed capacity
+// of the service in metrics
+func WithMetrics(metrics *Metrics) DynamoDBServiceOption {
+	return func(db *dynamoDBSerivce) {
+		db.metrics = metrics
+	}
+}
+
+// WithTracing traces the scan pages, batch write attempts, table updates and waits for the table to be ready
+func WithTracing(tracing *Tracing) DynamoDBServiceOption {
+	return func(db *dynamoDBSerivce) {
+		db.tracing = tracing
+	}
+}
+
+// WithRetryPolicy retries the throttled writes and the failed scans according to retryPolicy
+// instead of DefaultRetryPolicy.
+// The delays of retryPolicy are already randomized, so the service sleepFn should be Sleep rather than RandomSleeper
+func WithRetryPolicy(retryPolicy RetryPolicy) DynamoDBServiceOption {
+	return func(db *dynamoDBSerivce) {
+		db.retries = retryPolicy
+	}
+}
+
+// WithTableWaitPolicy waits for the table to be ready according to waitPolicy instead of DefaultTableWaitPolicy
+func WithTableWaitPolicy(waitPolicy RetryPolicy) DynamoDBServiceOption {
+	return func(db *dynamoDBSerivce) {
+		db.waits = waitPolicy
+	}
 }
 
 // NewDynamoDBService creates new service for a given DynamoDB table with a previously configured DynamoDB client
-func NewDynamoDBService(tableName string, client DynamoDBClient, sleepFn Sleeper, logger Logger) DynamoDBService {
-	return dynamoDBSerivce{tableName, client, sleepFn, logger}
+func NewDynamoDBService(
+	tableName string,
+	client DynamoDBClient,
+	sleepFn Sleeper,
+	logger Logger,
+	options ...DynamoDBServiceOption,
+) DynamoDBService {
+	service := dynamoDBSerivce{
+		tableName: tableName,
+		client:    client,
+		sleep:     sleepFn,
+		logger:    logger,
+		consumed:  &consumedCapacityCounter{mutex: &sync.Mutex{}},
+		retries:   DefaultRetryPolicy(),
+		waits:     DefaultTableWaitPolicy(),
+	}
+	for _, option := range options {
+		option(&service)
+	}
+
+	return service
+}
+
+// ConsumedCapacity returns the total capacity units consumed by the scans and batch writes of the service
+func (db dynamoDBSerivce) ConsumedCapacity() ConsumedCapacity {
+	return db.consumed.get()
 }
 
 // DescribeTable returns the current table metadata for the DynamoDB table
@@ -79,7 +250,12 @@ func (db dynamoDBSerivce) DescribeTable() (*dynamodb.TableDescription, error) {
 		TableName: aws.String(db.tableName),
 	}
 
-	output, err := db.client.DescribeTable(input)
+	var output *dynamodb.DescribeTableOutput
+	err := db.call("describe_table", func() (err error) {
+		output, err = db.client.DescribeTable(input)
+
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("unable to describe table %s: %s", db.tableName, err)
 	}
@@ -109,46 +285,174 @@ func (db dynamoDBSerivce) UpdateCapacity(capacity Capacity) error {
 	}
 
 	db.logger.Printf("updating %s with read: %d, write: %d", db.tableName, read, write)
-	_, err := db.client.UpdateTable(input)
-	if err != nil {
+	if err := db.updateTable(input); err != nil {
 		return fmt.Errorf("unable to update table %s: %s", db.tableName, err)
 	}
 
 	return db.WaitForReadyTable()
 }
 
+// UpdateProvisioning sets the read and write capacity of the table and of the given global secondary indexes in a
+// single table update, waiting for the table to be ready for processing. A nil capacity keeps the table capacity
+func (db dynamoDBSerivce) UpdateProvisioning(capacity *Capacity, indexes map[string]*Capacity) error {
+	input := &dynamodb.UpdateTableInput{TableName: aws.String(db.tableName)}
+
+	if capacity != nil {
+		if capacity.Read == 0 || capacity.Write == 0 {
+			return fmt.Errorf(
+				"invalid update capacity read %d, write %d: capacity units must be greater than 0",
+				capacity.Read,
+				capacity.Write,
+			)
+		}
+
+		input.SetProvisionedThroughput(&dynamodb.ProvisionedThroughput{
+			ReadCapacityUnits:  aws.Int64(capacity.Read),
+			WriteCapacityUnits: aws.Int64(capacity.Write),
+		})
+		db.logger.Printf("updating %s with read: %d, write: %d", db.tableName, capacity.Read, capacity.Write)
+	}
+
+	for _, index := range sortedIndexNames(indexes) {
+		indexCapacity := indexes[index]
+		if indexCapacity.Read == 0 || indexCapacity.Write == 0 {
+			return fmt.Errorf(
+				"invalid update capacity for index %s read %d, write %d: capacity units must be greater than 0",
+				index,
+				indexCapacity.Read,
+				indexCapacity.Write,
+			)
+		}
+
+		indexUpdate := &dynamodb.GlobalSecondaryIndexUpdate{
+			Update: &dynamodb.UpdateGlobalSecondaryIndexAction{
+				IndexName: aws.String(index),
+				ProvisionedThroughput: &dynamodb.ProvisionedThroughput{
+					ReadCapacityUnits:  aws.Int64(indexCapacity.Read),
+					WriteCapacityUnits: aws.Int64(indexCapacity.Write),
+				},
+			},
+		}
+		input.GlobalSecondaryIndexUpdates = append(input.GlobalSecondaryIndexUpdates, indexUpdate)
+		db.logger.Printf(
+			"updating %s index %s with read: %d, write: %d",
+			db.tableName,
+			index,
+			indexCapacity.Read,
+			indexCapacity.Write,
+		)
+	}
+
+	if err := db.updateTable(input); err != nil {
+		return fmt.Errorf("unable to update table %s provisioning: %s", db.tableName, err)
+	}
+
+	return db.WaitForReadyTable()
+}
+
+// UpdateBillingMode switches the table's billing mode, waiting for the table to be ready for processing.
+//
+// A nil capacity switches the table to on-demand (BillingModePayPerRequest), otherwise the table is switched to
+// BillingModeProvisioned with the given capacity. Since DynamoDB requires the provisioned throughput of every global
+// secondary index when switching to BillingModeProvisioned, the indexes are set with the same capacity as the table.
+func (db dynamoDBSerivce) UpdateBillingMode(capacity *Capacity) error {
+	input := &dynamodb.UpdateTableInput{
+		TableName:   aws.String(db.tableName),
+		BillingMode: aws.String(dynamodb.BillingModePayPerRequest),
+	}
+
+	if capacity != nil {
+		if capacity.Read == 0 || capacity.Write == 0 {
+			return fmt.Errorf(
+				"invalid billing mode capacity read %d, write %d: capacity units must be greater than 0",
+				capacity.Read,
+				capacity.Write,
+			)
+		}
+
+		description, err := db.DescribeTable()
+		if err != nil {
+			return err
+		}
+
+		throughput := &dynamodb.ProvisionedThroughput{
+			ReadCapacityUnits:  aws.Int64(capacity.Read),
+			WriteCapacityUnits: aws.Int64(capacity.Write),
+		}
+
+		input.SetBillingMode(dynamodb.BillingModeProvisioned)
+		input.SetProvisionedThroughput(throughput)
+		for _, index := range description.GlobalSecondaryIndexes {
+			indexUpdate := &dynamodb.GlobalSecondaryIndexUpdate{
+				Update: &dynamodb.UpdateGlobalSecondaryIndexAction{
+					IndexName:             index.IndexName,
+					ProvisionedThroughput: throughput,
+				},
+			}
+			input.GlobalSecondaryIndexUpdates = append(input.GlobalSecondaryIndexUpdates, indexUpdate)
+		}
+	}
+
+	db.logger.Printf("updating %s billing mode to %s", db.tableName, *input.BillingMode)
+	if err := db.updateTable(input); err != nil {
+		return fmt.Errorf("unable to update table %s billing mode: %s", db.tableName, err)
+	}
+
+	return db.WaitForReadyTable()
+}
+
 // BatchWrite writes the given DynamoDBItem slice into the DynamoDB table.
 //
 // The given items will be written in groups of 25 each.
 //
 // This method will retry:
 // 	1 - if there are any any unprocessed items when performing the BatchWrite
-// 	2 - if there is a Provisioning or Throttling aws error (tries for a max time of 3 minutes)
+// 	2 - if there is a Provisioning or Throttling aws error (according to the retry policy of the service)
 func (db dynamoDBSerivce) BatchWrite(items []DynamoDBItem) error {
 	db.logger.Printf("writing batch of %d to %s", len(items), db.tableName)
-	if len(items) == 0 {
-		return nil
+
+	requests := make([]*dynamodb.WriteRequest, len(items))
+	for i, item := range items {
+		requests[i] = &dynamodb.WriteRequest{
+			PutRequest: &dynamodb.PutRequest{
+				Item: item,
+			},
+		}
 	}
 
-	var remainingRequests []*dynamodb.WriteRequest
-	for _, item := range items {
-		if len(remainingRequests) == maxBatchWriteSize {
-			if err := db.batchWriteItem(remainingRequests); err != nil {
-				return err
-			}
+	return db.batchWriteRequests(requests)
+}
 
-			remainingRequests = nil
-		}
+// BatchDelete deletes the items with the given keys from the table, in batches of at most 25 keys
+func (db dynamoDBSerivce) BatchDelete(keys []DynamoDBItem) error {
+	db.logger.Printf("deleting batch of %d from %s", len(keys), db.tableName)
 
-		request := &dynamodb.WriteRequest{
-			PutRequest: &dynamodb.PutRequest{
-				Item: item,
+	requests := make([]*dynamodb.WriteRequest, len(keys))
+	for i, key := range keys {
+		requests[i] = &dynamodb.WriteRequest{
+			DeleteRequest: &dynamodb.DeleteRequest{
+				Key: key,
 			},
 		}
-		remainingRequests = append(remainingRequests, request)
 	}
 
-	return db.batchWriteItem(remainingRequests)
+	return db.batchWriteRequests(requests)
+}
+
+func (db dynamoDBSerivce) batchWriteRequests(requests []*dynamodb.WriteRequest) error {
+	for len(requests) > maxBatchWriteSize {
+		if err := db.batchWriteItem(requests[:maxBatchWriteSize]); err != nil {
+			return err
+		}
+
+		requests = requests[maxBatchWriteSize:]
+	}
+
+	if len(requests) == 0 {
+		return nil
+	}
+
+	return db.batchWriteItem(requests)
 }
 
 func (db dynamoDBSerivce) batchWriteItem(requests []*dynamodb.WriteRequest) error {
@@ -161,31 +465,59 @@ func (db dynamoDBSerivce) batchWriteItem(requests []*dynamodb.WriteRequest) erro
 				RequestItems: map[string][]*dynamodb.WriteRequest{
 					tableName: writeRequests,
 				},
+				ReturnConsumedCapacity: aws.String(dynamodb.ReturnConsumedCapacityTotal),
 			}
 
+			span := db.tracing.start(
+				"dynamodb.BatchWriteItem",
+				attribute.String(attributeTable, tableName),
+				attribute.Int(attributeAttempt, attempt),
+				attribute.Int(attributeItems, len(writeRequests)),
+			)
+			defer span.End()
+
+			start := time.Now()
 			output, err := db.client.BatchWriteItem(batchInput)
 			if err == nil {
-				writeRequests = output.UnprocessedItems[tableName]
+				unprocessed := output.UnprocessedItems[tableName]
+				units := 0.0
+				for _, consumed := range output.ConsumedCapacity {
+					units += capacityUnits(consumed)
+				}
+				db.consumed.add(0, units)
+				db.metrics.batchWritten(
+					tableName,
+					time.Since(start),
+					len(writeRequests)-len(unprocessed),
+					len(unprocessed),
+					units,
+				)
+				span.SetAttributes(
+					attribute.Int(attributeUnprocessed, len(unprocessed)),
+					attribute.Float64(attributeCapacityUnits, units),
+				)
+				writeRequests = unprocessed
 
 				return true, nil
 			}
 
+			recordSpanError(span, err)
+
+			if class, reason := ClassifyError(err); class == ErrorThrottled {
+				span.SetAttributes(attribute.String(attributeThrottleReason, reason))
+			}
+
+			if db.retryableError("batch_write", err, attempt, elapsed) {
+				return false, nil
+			}
+
 			if awsErr, ok := err.(awserr.Error); ok {
-				switch awsErr.Code() {
-				case dynamodb.ErrCodeProvisionedThroughputExceededException:
-					db.logger.Printf("batch write provisioning error: waited %d ms (attempt %d)", elapsed, attempt)
-					return false, nil
-				case errCodeThrottlingException:
-					db.logger.Printf("batch write throttling error: waited %d ms (attempt %d)", elapsed, attempt)
-					return false, nil
-				default:
-					return false, fmt.Errorf(
-						"aws %s error in batch write to table %s: %s",
-						awsErr.Code(),
-						db.tableName,
-						awsErr.Error(),
-					)
-				}
+				return false, fmt.Errorf(
+					"aws %s error in batch write to table %s: %s",
+					awsErr.Code(),
+					db.tableName,
+					awsErr.Error(),
+				)
 			}
 
 			return false, fmt.Errorf("unable to batch write to table %s: %s", db.tableName, err)
@@ -199,22 +531,234 @@ func (db dynamoDBSerivce) batchWriteItem(requests []*dynamodb.WriteRequest) erro
 	return nil
 }
 
-// WaitForReadyTable will wait for the table status to be active (waits for 3 minutes)
+// ConditionalPut puts the item into the table only if the condition holds, returning false when it doesn't
+func (db dynamoDBSerivce) ConditionalPut(item DynamoDBItem, condition WriteCondition) (bool, error) {
+	input := &dynamodb.PutItemInput{
+		TableName:                 aws.String(db.tableName),
+		Item:                      item,
+		ConditionExpression:       aws.String(condition.Expression),
+		ExpressionAttributeNames:  condition.Names,
+		ExpressionAttributeValues: condition.Values,
+		ReturnConsumedCapacity:    aws.String(dynamodb.ReturnConsumedCapacityTotal),
+	}
+
+	written := false
+	err := db.retry(func(attempt, elapsed int) (bool, error) {
+		output, err := db.client.PutItem(input)
+		if err == nil {
+			if output.ConsumedCapacity != nil {
+				db.consumed.add(0, aws.Float64Value(output.ConsumedCapacity.CapacityUnits))
+			}
+			db.metrics.written(db.tableName, capacityUnits(output.ConsumedCapacity))
+			written = true
+
+			return true, nil
+		}
+
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == dynamodb.ErrCodeConditionalCheckFailedException {
+			return true, nil
+		}
+
+		if db.retryableError("put_item", err, attempt, elapsed) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("unable to put item into table %s: %s", db.tableName, err)
+	})
+
+	return written, err
+}
+
+// WaitForReadyTable will wait for the table and global secondary indexes status to be active, polling according
+// to the retry policy of the service (for at most 3 minutes by default)
 func (db dynamoDBSerivce) WaitForReadyTable() error {
-	return db.retry(func(attempt, elapsed int) (bool, error) {
+	span := db.tracing.start("dynamodb.WaitForReadyTable", attribute.String(attributeTable, db.tableName))
+
+	// the table waits have their own retry policy, with a longer budget than the throttled requests
+	err := db.retryProgress(db.waits, func(attempt, elapsed int) (bool, bool, error) {
+		span.SetAttributes(attribute.Int(attributeAttempt, attempt))
+
 		description, err := db.DescribeTable()
 		if err != nil {
-			return false, err
+			return false, false, err
+		}
+
+		if *description.TableStatus != dynamodb.TableStatusActive {
+			return false, false, nil
+		}
+
+		for _, index := range description.GlobalSecondaryIndexes {
+			if index.IndexStatus != nil && *index.IndexStatus != dynamodb.IndexStatusActive {
+				return false, false, nil
+			}
 		}
 
-		return *description.TableStatus == dynamodb.TableStatusActive, nil
+		return true, false, nil
 	})
+	endSpan(span, err)
+
+	return err
+}
+
+// updateTable performs an UpdateTable request, tracing it
+func (db dynamoDBSerivce) updateTable(input *dynamodb.UpdateTableInput) error {
+	span := db.tracing.start("dynamodb.UpdateTable", attribute.String(attributeTable, db.tableName))
+
+	err := db.call("update_table", func() error {
+		_, err := db.client.UpdateTable(input)
+
+		return err
+	})
+	endSpan(span, err)
+
+	return err
+}
+
+// capacityUnits returns the capacity units of a ConsumedCapacity, or 0 when it's nil
+func capacityUnits(consumed *dynamodb.ConsumedCapacity) float64 {
+	if consumed == nil {
+		return 0
+	}
+
+	return aws.Float64Value(consumed.CapacityUnits)
+}
+
+// retryableError classifies the error of an attempt of operation (e.g. batch_write), recording and logging it
+// when the attempt can be retried
+func (db dynamoDBSerivce) retryableError(operation string, err error, attempt, elapsed int) bool {
+	class, reason := ClassifyError(err)
+	if class == ErrorCredentials {
+		return db.refreshCredentials(operation, attempt)
+	}
+
+	if class != ErrorThrottled && class != ErrorRetryable {
+		return false
+	}
+
+	if class == ErrorThrottled {
+		db.metrics.throttled(db.tableName, operation, reason)
+	}
+
+	format := fmt.Sprintf("%s %s error: waited %%d ms (attempt %%d)", strings.Replace(operation, "_", " ", -1), reason)
+	db.logRetry(format, attempt, elapsed)
+
+	return true
+}
+
+// refreshCredentials refreshes the expired credentials of the client, when it's a CredentialsRefresher,
+// returning whether the failed attempt of operation can be retried
+func (db dynamoDBSerivce) refreshCredentials(operation string, attempt int) bool {
+	refresher, ok := db.client.(CredentialsRefresher)
+	if !ok {
+		return false
+	}
+
+	logWith(
+		db.logger,
+		LevelWarn,
+		Fields{"table": db.tableName, "attempt": attempt},
+		"%s on %s table failed with expired credentials: refreshing them (attempt %d)",
+		strings.Replace(operation, "_", " ", -1),
+		db.tableName,
+		attempt,
+	)
+	refresher.RefreshCredentials()
+
+	return true
+}
+
+// call performs a single request with fn, retrying its throttled and retryable errors according to the retry
+// policy of the service
+func (db dynamoDBSerivce) call(operation string, fn func() error) error {
+	var lastErr error
+	err := db.retry(func(attempt, elapsed int) (bool, error) {
+		lastErr = fn()
+		if lastErr == nil {
+			return true, nil
+		}
+
+		if db.retryableError(operation, lastErr, attempt, elapsed) {
+			return false, nil
+		}
+
+		return false, lastErr
+	})
+
+	if err != nil && err != lastErr {
+		return fmt.Errorf("%s: %s", err, lastErr)
+	}
+
+	return err
+}
+
+// retryPages performs a paginated read with fetch until it succeeds, retrying the throttled and retryable errors
+// according to the retry policy of the service. Since the pages already received aren't read again,
+// fetch must resume the read from the ExclusiveStartKey of the last page received.
+//
+// The attempts and elapsed time of the retry policy are reset whenever a page is received, so that the throttles
+// spread over a long read don't exhaust them as long as it keeps making progress
+func (db dynamoDBSerivce) retryPages(operation string, pages *pageTracer, fetch func() error) error {
+	var lastErr error
+	err := db.retryProgress(db.retries, func(attempt, elapsed int) (bool, bool, error) {
+		pages.restart()
+
+		received := pages.received
+		lastErr = fetch()
+		if lastErr == nil {
+			return true, false, nil
+		}
+		pages.end(lastErr)
+
+		progressed := pages.received > received
+		if progressed {
+			attempt, elapsed = 0, 0
+		}
+
+		if db.retryableError(operation, lastErr, attempt, elapsed) {
+			return false, progressed, nil
+		}
+
+		return false, false, lastErr
+	})
+
+	if err != nil && err != lastErr {
+		return fmt.Errorf("%s: %s", err, lastErr)
+	}
+
+	return err
+}
+
+// logRetry logs a retried operation, with the table, attempt and elapsed fields for leveled loggers
+func (db dynamoDBSerivce) logRetry(format string, attempt, elapsed int) {
+	fields := Fields{"table": db.tableName, "attempt": attempt, "elapsed": elapsed}
+	logWith(db.logger, LevelWarn, fields, format, elapsed, attempt)
+}
+
+// logSegment logs the progress of a scan segment, with the table and segment fields for leveled loggers
+func (db dynamoDBSerivce) logSegment(segment int, format string, msg ...interface{}) {
+	logWith(db.logger, LevelDebug, Fields{"table": db.tableName, "segment": segment}, format, msg...)
 }
 
+// retry calls handler until it handles the operation or fails, sleeping between the attempts according to the
+// retry policy of the service. The handler receives the attempt number (starting at 0) and the elapsed time in ms
 func (db dynamoDBSerivce) retry(handler func(attempt, elapsed int) (bool, error)) error {
-	elapsed := 0
-	for attempt := 0; elapsed < maxRetryTime; attempt++ {
+	return db.retryProgress(db.retries, func(attempt, elapsed int) (bool, bool, error) {
 		handled, err := handler(attempt, elapsed)
+
+		return handled, false, err
+	})
+}
+
+// retryProgress calls handler like retry according to policy, also resetting the attempt number and the elapsed time
+// when the handler reports that the failed attempt made some progress, as if it was the first attempt
+func (db dynamoDBSerivce) retryProgress(
+	policy RetryPolicy,
+	handler func(attempt, elapsed int) (bool, bool, error),
+) error {
+	elapsed := 0
+	var delay time.Duration
+	for attempt := 0; ; attempt++ {
+		handled, progressed, err := handler(attempt, elapsed)
 		if err != nil {
 			return err
 		}
@@ -223,21 +767,305 @@ func (db dynamoDBSerivce) retry(handler func(attempt, elapsed int) (bool, error)
 			return nil
 		}
 
-		elapsed += db.sleep(elapsed * attempt)
+		if progressed {
+			attempt, elapsed, delay = 0, 0, 0
+		}
+
+		var retry bool
+		delay, retry = policy.Delay(attempt+1, time.Duration(elapsed)*time.Millisecond, delay)
+		if !retry {
+			return fmt.Errorf(
+				"gave up after %d attempts (waited %d ms) to perform operation on %s table",
+				attempt+1,
+				elapsed,
+				db.tableName,
+			)
+		}
+
+		elapsed += db.sleep(int(delay / time.Millisecond))
+	}
+}
+
+// IsEmpty returns true when the table has no items, scanning at most one item
+func (db dynamoDBSerivce) IsEmpty() (bool, error) {
+	input := &dynamodb.ScanInput{
+		TableName: aws.String(db.tableName),
+		Limit:     aws.Int64(1),
+	}
+
+	var output *dynamodb.ScanOutput
+	err := db.call("scan", func() (err error) {
+		output, err = db.client.Scan(input)
+
+		return err
+	})
+	if err != nil {
+		return false, fmt.Errorf("unable to scan table %s: %s", db.tableName, err)
+	}
+
+	return len(output.Items) == 0 && len(output.LastEvaluatedKey) == 0, nil
+}
+
+// Tags returns the tags of the table
+func (db dynamoDBSerivce) Tags() (map[string]string, error) {
+	description, err := db.DescribeTable()
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make(map[string]string)
+	input := &dynamodb.ListTagsOfResourceInput{
+		ResourceArn: description.TableArn,
+	}
+	for {
+		var output *dynamodb.ListTagsOfResourceOutput
+		err := db.call("list_tags", func() (err error) {
+			output, err = db.client.ListTagsOfResource(input)
+
+			return err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to list tags of table %s: %s", db.tableName, err)
+		}
+
+		for _, tag := range output.Tags {
+			tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+		}
+
+		if output.NextToken == nil {
+			return tags, nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
+
+// TimeToLive returns the time to live settings of the table
+func (db dynamoDBSerivce) TimeToLive() (*dynamodb.TimeToLiveDescription, error) {
+	input := &dynamodb.DescribeTimeToLiveInput{
+		TableName: aws.String(db.tableName),
+	}
+
+	var output *dynamodb.DescribeTimeToLiveOutput
+	err := db.call("describe_time_to_live", func() (err error) {
+		output, err = db.client.DescribeTimeToLive(input)
+
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to describe time to live of table %s: %s", db.tableName, err)
+	}
+
+	return output.TimeToLiveDescription, nil
+}
+
+// PointInTimeRecovery returns true when the point in time recovery (continuous backups) of the table is enabled
+func (db dynamoDBSerivce) PointInTimeRecovery() (bool, error) {
+	input := &dynamodb.DescribeContinuousBackupsInput{
+		TableName: aws.String(db.tableName),
+	}
+
+	var output *dynamodb.DescribeContinuousBackupsOutput
+	err := db.call("describe_continuous_backups", func() (err error) {
+		output, err = db.client.DescribeContinuousBackups(input)
+
+		return err
+	})
+	if err != nil {
+		return false, fmt.Errorf("unable to describe continuous backups of table %s: %s", db.tableName, err)
+	}
+
+	description := output.ContinuousBackupsDescription
+	if description == nil || description.PointInTimeRecoveryDescription == nil {
+		return false, nil
+	}
+	status := aws.StringValue(description.PointInTimeRecoveryDescription.PointInTimeRecoveryStatus)
+
+	return status == dynamodb.PointInTimeRecoveryStatusEnabled, nil
+}
+
+// UpdateTimeToLive enables (or disables) the time to live of the table on the given attribute
+func (db dynamoDBSerivce) UpdateTimeToLive(attributeName string, enabled bool) error {
+	input := &dynamodb.UpdateTimeToLiveInput{
+		TableName: aws.String(db.tableName),
+		TimeToLiveSpecification: &dynamodb.TimeToLiveSpecification{
+			AttributeName: aws.String(attributeName),
+			Enabled:       aws.Bool(enabled),
+		},
+	}
+
+	db.logger.Printf("updating %s time to live on %s (enabled: %t)", db.tableName, attributeName, enabled)
+	err := db.call("update_time_to_live", func() error {
+		_, err := db.client.UpdateTimeToLive(input)
+
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("unable to update time to live of table %s: %s", db.tableName, err)
+	}
+
+	return nil
+}
+
+// UpdatePointInTimeRecovery enables (or disables) the point in time recovery (continuous backups) of the table
+func (db dynamoDBSerivce) UpdatePointInTimeRecovery(enabled bool) error {
+	input := &dynamodb.UpdateContinuousBackupsInput{
+		TableName: aws.String(db.tableName),
+		PointInTimeRecoverySpecification: &dynamodb.PointInTimeRecoverySpecification{
+			PointInTimeRecoveryEnabled: aws.Bool(enabled),
+		},
+	}
+
+	db.logger.Printf("updating %s point in time recovery (enabled: %t)", db.tableName, enabled)
+	err := db.call("update_continuous_backups", func() error {
+		_, err := db.client.UpdateContinuousBackups(input)
+
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("unable to update continuous backups of table %s: %s", db.tableName, err)
+	}
+
+	return nil
+}
+
+// ContributorInsights returns true when the contributor insights of the table are enabled (or being enabled)
+func (db dynamoDBSerivce) ContributorInsights() (bool, error) {
+	input := &dynamodb.DescribeContributorInsightsInput{
+		TableName: aws.String(db.tableName),
+	}
+
+	var output *dynamodb.DescribeContributorInsightsOutput
+	err := db.call("describe_contributor_insights", func() (err error) {
+		output, err = db.client.DescribeContributorInsights(input)
+
+		return err
+	})
+	if err != nil {
+		return false, fmt.Errorf("unable to describe contributor insights of table %s: %s", db.tableName, err)
+	}
+
+	switch aws.StringValue(output.ContributorInsightsStatus) {
+	case dynamodb.ContributorInsightsStatusEnabled, dynamodb.ContributorInsightsStatusEnabling:
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+// UpdateContributorInsights enables (or disables) the contributor insights of the table
+func (db dynamoDBSerivce) UpdateContributorInsights(enabled bool) error {
+	action := dynamodb.ContributorInsightsActionDisable
+	if enabled {
+		action = dynamodb.ContributorInsightsActionEnable
+	}
+
+	input := &dynamodb.UpdateContributorInsightsInput{
+		TableName:                 aws.String(db.tableName),
+		ContributorInsightsAction: aws.String(action),
 	}
 
-	return fmt.Errorf("waited for too long (%d ms) to perform operation on %s table", elapsed, db.tableName)
+	db.logger.Printf("updating %s contributor insights (enabled: %t)", db.tableName, enabled)
+	err := db.call("update_contributor_insights", func() error {
+		_, err := db.client.UpdateContributorInsights(input)
+
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("unable to update contributor insights of table %s: %s", db.tableName, err)
+	}
+
+	return nil
+}
+
+// UpdateDeletionProtection enables (or disables) the deletion protection of the table,
+// waiting for the table to be ready afterwards
+func (db dynamoDBSerivce) UpdateDeletionProtection(enabled bool) error {
+	input := &dynamodb.UpdateTableInput{
+		TableName:                 aws.String(db.tableName),
+		DeletionProtectionEnabled: aws.Bool(enabled),
+	}
+
+	db.logger.Printf("updating %s deletion protection (enabled: %t)", db.tableName, enabled)
+	if err := db.updateTable(input); err != nil {
+		return fmt.Errorf("unable to update table %s deletion protection: %s", db.tableName, err)
+	}
+
+	return db.WaitForReadyTable()
+}
+
+// UpdateStream updates the stream specification of the table, waiting for the table to be ready afterwards
+func (db dynamoDBSerivce) UpdateStream(stream *dynamodb.StreamSpecification) error {
+	input := &dynamodb.UpdateTableInput{
+		TableName:           aws.String(db.tableName),
+		StreamSpecification: stream,
+	}
+
+	db.logger.Printf("updating %s stream (enabled: %t)", db.tableName, aws.BoolValue(stream.StreamEnabled))
+	if err := db.updateTable(input); err != nil {
+		return fmt.Errorf("unable to update table %s stream: %s", db.tableName, err)
+	}
+
+	return db.WaitForReadyTable()
+}
+
+// TagTable adds the given tags to the table, overwriting the values of the existing ones
+func (db dynamoDBSerivce) TagTable(tags map[string]string) error {
+	description, err := db.DescribeTable()
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	input := &dynamodb.TagResourceInput{
+		ResourceArn: description.TableArn,
+	}
+	for _, key := range keys {
+		input.Tags = append(input.Tags, &dynamodb.Tag{Key: aws.String(key), Value: aws.String(tags[key])})
+	}
+
+	db.logger.Printf("tagging %s with %d tags", db.tableName, len(input.Tags))
+	err = db.call("tag_resource", func() error {
+		_, err := db.client.TagResource(input)
+
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("unable to tag table %s: %s", db.tableName, err)
+	}
+
+	return nil
 }
 
 // Scan allows you to perform a parallel scan over the table, writing the scanned items into the provided itemsChan
 // If totalSegments is equal to 1, it will perform a sequential scan.
 func (db dynamoDBSerivce) Scan(totalSegments, segment int, itemsChan chan<- []DynamoDBItem) error {
+	return db.ScanWithOptions(ScanOptions{}, totalSegments, segment, itemsChan)
+}
+
+// ScanWithOptions performs a scan like Scan, applying the given ScanOptions
+func (db dynamoDBSerivce) ScanWithOptions(
+	options ScanOptions,
+	totalSegments, segment int,
+	itemsChan chan<- []DynamoDBItem,
+) error {
 	if totalSegments == 0 {
 		return errors.New("totalSegments has to be greater than 0")
 	}
 
+	projection, filter, names := options.expressions()
 	input := dynamodb.ScanInput{
-		TableName: aws.String(db.tableName),
+		TableName:                 aws.String(db.tableName),
+		IndexName:                 options.indexName(),
+		ProjectionExpression:      projection,
+		FilterExpression:          filter,
+		ExpressionAttributeNames:  names,
+		ExpressionAttributeValues: options.ExpressionAttributeValues,
+		ReturnConsumedCapacity:    aws.String(dynamodb.ReturnConsumedCapacityTotal),
 	}
 
 	if totalSegments > 1 {
@@ -245,25 +1073,154 @@ func (db dynamoDBSerivce) Scan(totalSegments, segment int, itemsChan chan<- []Dy
 		input.SetTotalSegments(int64(totalSegments))
 	}
 
+	pages := newPageTracer(
+		db.tracing,
+		"dynamodb.ScanPage",
+		attribute.String(attributeTable, db.tableName),
+		attribute.Int(attributeSegment, segment),
+	)
+
 	totalScanned := 0
 	pagerFn := func(output *dynamodb.ScanOutput, b bool) bool {
+		pages.page(int64(len(output.Items)), output.ConsumedCapacity)
+		input.ExclusiveStartKey = output.LastEvaluatedKey
+
 		var items []DynamoDBItem
 		for _, item := range output.Items {
 			items = append(items, item)
 			totalScanned++
 		}
-		db.logger.Printf("%s table scanned page with %d items (reader %d)", db.tableName, len(items), segment)
+		db.logSegment(segment, "%s table scanned page with %d items (reader %d)", db.tableName, len(items), segment)
+
+		if output.ConsumedCapacity != nil {
+			db.consumed.add(aws.Float64Value(output.ConsumedCapacity.CapacityUnits), 0)
+		}
+		db.metrics.scanned(db.tableName, len(items), capacityUnits(output.ConsumedCapacity))
 
 		itemsChan <- items
+		pages.next(b)
 
 		return !b
 	}
 
-	if err := db.client.ScanPages(&input, pagerFn); err != nil {
+	err := db.retryPages("scan", pages, func() error {
+		return db.client.ScanPages(&input, pagerFn)
+	})
+	if err != nil {
 		return fmt.Errorf("unable to scan table %s: %s", db.tableName, err)
 	}
 
-	db.logger.Printf("%s table scanned a total of %d items (reader %d)", db.tableName, totalScanned, segment)
+	db.logSegment(segment, "%s table scanned a total of %d items (reader %d)", db.tableName, totalScanned, segment)
+
+	return nil
+}
+
+// Query reads the items matching the key condition expression, writing them into the provided itemsChan
+func (db dynamoDBSerivce) Query(keyCondition string, options ScanOptions, itemsChan chan<- []DynamoDBItem) error {
+	projection, filter, names := options.expressions()
+	input := dynamodb.QueryInput{
+		TableName:                 aws.String(db.tableName),
+		IndexName:                 options.indexName(),
+		KeyConditionExpression:    aws.String(keyCondition),
+		ProjectionExpression:      projection,
+		FilterExpression:          filter,
+		ExpressionAttributeNames:  names,
+		ExpressionAttributeValues: options.ExpressionAttributeValues,
+		ReturnConsumedCapacity:    aws.String(dynamodb.ReturnConsumedCapacityTotal),
+	}
+
+	pages := newPageTracer(db.tracing, "dynamodb.QueryPage", attribute.String(attributeTable, db.tableName))
+
+	totalQueried := 0
+	pagerFn := func(output *dynamodb.QueryOutput, b bool) bool {
+		pages.page(int64(len(output.Items)), output.ConsumedCapacity)
+		input.ExclusiveStartKey = output.LastEvaluatedKey
+
+		items := make([]DynamoDBItem, len(output.Items))
+		for i, item := range output.Items {
+			items[i] = item
+		}
+		totalQueried += len(items)
+		db.logger.Printf("%s table queried page with %d items", db.tableName, len(items))
+
+		if output.ConsumedCapacity != nil {
+			db.consumed.add(aws.Float64Value(output.ConsumedCapacity.CapacityUnits), 0)
+		}
+		db.metrics.scanned(db.tableName, len(items), capacityUnits(output.ConsumedCapacity))
+
+		itemsChan <- items
+		pages.next(b)
+
+		return !b
+	}
+
+	err := db.retryPages("query", pages, func() error {
+		return db.client.QueryPages(&input, pagerFn)
+	})
+	if err != nil {
+		return fmt.Errorf("unable to query table %s: %s", db.tableName, err)
+	}
+
+	db.logger.Printf("%s table queried a total of %d items", db.tableName, totalQueried)
 
 	return nil
 }
+
+// Count counts the items of a scan segment matching the given ScanOptions, without reading them (Select: COUNT).
+// Like Scan, if totalSegments is equal to 1, it will count the items sequentially
+func (db dynamoDBSerivce) Count(options ScanOptions, totalSegments, segment int) (int64, error) {
+	if totalSegments == 0 {
+		return 0, errors.New("totalSegments has to be greater than 0")
+	}
+
+	_, filter, names := options.expressions()
+	input := dynamodb.ScanInput{
+		TableName:                 aws.String(db.tableName),
+		IndexName:                 options.indexName(),
+		Select:                    aws.String(dynamodb.SelectCount),
+		FilterExpression:          filter,
+		ExpressionAttributeNames:  names,
+		ExpressionAttributeValues: options.ExpressionAttributeValues,
+		ReturnConsumedCapacity:    aws.String(dynamodb.ReturnConsumedCapacityTotal),
+	}
+
+	if totalSegments > 1 {
+		input.SetSegment(int64(segment))
+		input.SetTotalSegments(int64(totalSegments))
+	}
+
+	pages := newPageTracer(
+		db.tracing,
+		"dynamodb.ScanPage",
+		attribute.String(attributeTable, db.tableName),
+		attribute.Int(attributeSegment, segment),
+	)
+
+	var count int64
+	pagerFn := func(output *dynamodb.ScanOutput, b bool) bool {
+		pageCount := aws.Int64Value(output.Count)
+		pages.page(pageCount, output.ConsumedCapacity)
+		input.ExclusiveStartKey = output.LastEvaluatedKey
+		count += pageCount
+		db.logSegment(segment, "%s table counted page with %d items (reader %d)", db.tableName, pageCount, segment)
+
+		if output.ConsumedCapacity != nil {
+			db.consumed.add(aws.Float64Value(output.ConsumedCapacity.CapacityUnits), 0)
+		}
+		db.metrics.consumedRead(db.tableName, capacityUnits(output.ConsumedCapacity))
+		pages.next(b)
+
+		return !b
+	}
+
+	err := db.retryPages("count", pages, func() error {
+		return db.client.ScanPages(&input, pagerFn)
+	})
+	if err != nil {
+		return 0, fmt.Errorf("unable to count items of table %s: %s", db.tableName, err)
+	}
+
+	db.logSegment(segment, "%s table counted a total of %d items (reader %d)", db.tableName, count, segment)
+
+	return count, nil
+}
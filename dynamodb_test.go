@@ -51,6 +51,16 @@ func TestDescribeTable(t *testing.T) {
 			false,
 			expectedDescription.Table,
 		},
+		{
+			"SuccessAfterRetryableError",
+			func(api *mocks.DynamoDBAPI) {
+				err := awserr.New(dynamodb.ErrCodeInternalServerError, "err", expectedError)
+				api.On("DescribeTable", descriptionMock).Return(nil, err).Once()
+				api.On("DescribeTable", descriptionMock).Return(expectedDescription, nil).Once()
+			},
+			false,
+			expectedDescription.Table,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -144,6 +154,229 @@ func TestUpdateCapacity(t *testing.T) {
 	}
 }
 
+func TestUpdateProvisioning(t *testing.T) {
+	t.Parallel()
+
+	expectedError := errors.New("updateProvisioningError")
+
+	describeMock := mock.AnythingOfType("*dynamodb.DescribeTableInput")
+
+	capacity := &dynamodbcopy.Capacity{Read: 10, Write: 10}
+	updateInput := &dynamodb.UpdateTableInput{
+		TableName: aws.String(expectedTableName),
+		ProvisionedThroughput: &dynamodb.ProvisionedThroughput{
+			ReadCapacityUnits:  aws.Int64(10),
+			WriteCapacityUnits: aws.Int64(10),
+		},
+		GlobalSecondaryIndexUpdates: []*dynamodb.GlobalSecondaryIndexUpdate{
+			buildIndexUpdate("index1", 5, 10),
+			buildIndexUpdate("index2", 5, 10),
+		},
+	}
+	indexes := map[string]*dynamodbcopy.Capacity{"index2": {Read: 5, Write: 10}, "index1": {Read: 5, Write: 10}}
+
+	testCases := []struct {
+		subTestName   string
+		mocker        func(api *mocks.DynamoDBAPI)
+		capacity      *dynamodbcopy.Capacity
+		indexes       map[string]*dynamodbcopy.Capacity
+		errorExpected bool
+	}{
+		{
+			"ZeroError",
+			func(api *mocks.DynamoDBAPI) {},
+			&dynamodbcopy.Capacity{Read: 10, Write: 0},
+			nil,
+			true,
+		},
+		{
+			"ZeroIndexError",
+			func(api *mocks.DynamoDBAPI) {},
+			nil,
+			map[string]*dynamodbcopy.Capacity{"index": {Read: 0, Write: 10}},
+			true,
+		},
+		{
+			"Error",
+			func(api *mocks.DynamoDBAPI) {
+				api.On("UpdateTable", updateInput).Return(nil, expectedError).Once()
+			},
+			capacity,
+			indexes,
+			true,
+		},
+		{
+			"Update",
+			func(api *mocks.DynamoDBAPI) {
+				api.On("UpdateTable", updateInput).Return(&dynamodb.UpdateTableOutput{}, nil).Once()
+				output := buildDescribeTableOutput(expectedTableName, dynamodb.TableStatusActive)
+				api.On("DescribeTable", describeMock).Return(output, nil).Once()
+			},
+			capacity,
+			indexes,
+			false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.subTestName,
+			func(st *testing.T) {
+				api := &mocks.DynamoDBAPI{}
+
+				testCase.mocker(api)
+
+				service := dynamodbcopy.NewDynamoDBService(
+					expectedTableName,
+					api,
+					testSleeper,
+					log.New(ioutil.Discard, "", log.Ltime),
+				)
+
+				err := service.UpdateProvisioning(testCase.capacity, testCase.indexes)
+
+				assertExpectedError(st, testCase.errorExpected, err)
+
+				api.AssertExpectations(st)
+			},
+		)
+	}
+}
+
+func buildIndexUpdate(index string, r, w int64) *dynamodb.GlobalSecondaryIndexUpdate {
+	return &dynamodb.GlobalSecondaryIndexUpdate{
+		Update: &dynamodb.UpdateGlobalSecondaryIndexAction{
+			IndexName: aws.String(index),
+			ProvisionedThroughput: &dynamodb.ProvisionedThroughput{
+				ReadCapacityUnits:  aws.Int64(r),
+				WriteCapacityUnits: aws.Int64(w),
+			},
+		},
+	}
+}
+
+func TestUpdateBillingMode(t *testing.T) {
+	t.Parallel()
+
+	expectedError := errors.New("updateBillingModeError")
+
+	describeMock := mock.AnythingOfType("*dynamodb.DescribeTableInput")
+
+	activeOutput := buildDescribeTableOutput(expectedTableName, dynamodb.TableStatusActive)
+	indexOutput := buildDescribeTableOutput(expectedTableName, dynamodb.TableStatusActive)
+	indexOutput.Table.GlobalSecondaryIndexes = []*dynamodb.GlobalSecondaryIndexDescription{
+		{IndexName: aws.String("index")},
+	}
+
+	throughput := &dynamodb.ProvisionedThroughput{
+		ReadCapacityUnits:  aws.Int64(10),
+		WriteCapacityUnits: aws.Int64(10),
+	}
+	onDemandInput := &dynamodb.UpdateTableInput{
+		TableName:   aws.String(expectedTableName),
+		BillingMode: aws.String(dynamodb.BillingModePayPerRequest),
+	}
+	provisionedInput := &dynamodb.UpdateTableInput{
+		TableName:             aws.String(expectedTableName),
+		BillingMode:           aws.String(dynamodb.BillingModeProvisioned),
+		ProvisionedThroughput: throughput,
+	}
+	indexInput := &dynamodb.UpdateTableInput{
+		TableName:             aws.String(expectedTableName),
+		BillingMode:           aws.String(dynamodb.BillingModeProvisioned),
+		ProvisionedThroughput: throughput,
+		GlobalSecondaryIndexUpdates: []*dynamodb.GlobalSecondaryIndexUpdate{
+			{
+				Update: &dynamodb.UpdateGlobalSecondaryIndexAction{
+					IndexName:             aws.String("index"),
+					ProvisionedThroughput: throughput,
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		subTestName   string
+		mocker        func(api *mocks.DynamoDBAPI)
+		capacity      *dynamodbcopy.Capacity
+		errorExpected bool
+	}{
+		{
+			"ZeroError",
+			func(api *mocks.DynamoDBAPI) {},
+			&dynamodbcopy.Capacity{Read: 0, Write: 10},
+			true,
+		},
+		{
+			"DescribeError",
+			func(api *mocks.DynamoDBAPI) {
+				api.On("DescribeTable", describeMock).Return(nil, expectedError).Once()
+			},
+			&dynamodbcopy.Capacity{Read: 10, Write: 10},
+			true,
+		},
+		{
+			"Error",
+			func(api *mocks.DynamoDBAPI) {
+				api.On("UpdateTable", onDemandInput).Return(nil, expectedError).Once()
+			},
+			nil,
+			true,
+		},
+		{
+			"OnDemand",
+			func(api *mocks.DynamoDBAPI) {
+				api.On("UpdateTable", onDemandInput).Return(&dynamodb.UpdateTableOutput{}, nil).Once()
+				api.On("DescribeTable", describeMock).Return(activeOutput, nil).Once()
+			},
+			nil,
+			false,
+		},
+		{
+			"Provisioned",
+			func(api *mocks.DynamoDBAPI) {
+				api.On("DescribeTable", describeMock).Return(activeOutput, nil).Twice()
+				api.On("UpdateTable", provisionedInput).Return(&dynamodb.UpdateTableOutput{}, nil).Once()
+			},
+			&dynamodbcopy.Capacity{Read: 10, Write: 10},
+			false,
+		},
+		{
+			"ProvisionedWithIndexes",
+			func(api *mocks.DynamoDBAPI) {
+				api.On("DescribeTable", describeMock).Return(indexOutput, nil).Twice()
+				api.On("UpdateTable", indexInput).Return(&dynamodb.UpdateTableOutput{}, nil).Once()
+			},
+			&dynamodbcopy.Capacity{Read: 10, Write: 10},
+			false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.subTestName,
+			func(st *testing.T) {
+				api := &mocks.DynamoDBAPI{}
+
+				testCase.mocker(api)
+
+				service := dynamodbcopy.NewDynamoDBService(
+					expectedTableName,
+					api,
+					testSleeper,
+					log.New(ioutil.Discard, "", log.Ltime),
+				)
+
+				err := service.UpdateBillingMode(testCase.capacity)
+
+				assertExpectedError(st, testCase.errorExpected, err)
+
+				api.AssertExpectations(st)
+			},
+		)
+	}
+}
+
 func TestWaitForReadyTable(t *testing.T) {
 	t.Parallel()
 
@@ -151,6 +384,10 @@ func TestWaitForReadyTable(t *testing.T) {
 
 	activeDescribeOutput := buildDescribeTableOutput(expectedTableName, dynamodb.TableStatusActive)
 	creatingDescribeOutput := buildDescribeTableOutput(expectedTableName, dynamodb.TableStatusCreating)
+	updatingIndexDescribeOutput := buildDescribeTableOutput(expectedTableName, dynamodb.TableStatusActive)
+	updatingIndexDescribeOutput.Table.GlobalSecondaryIndexes = []*dynamodb.GlobalSecondaryIndexDescription{
+		{IndexName: aws.String("index"), IndexStatus: aws.String(dynamodb.IndexStatusUpdating)},
+	}
 
 	descriptionMock := mock.AnythingOfType("*dynamodb.DescribeTableInput")
 
@@ -185,6 +422,15 @@ func TestWaitForReadyTable(t *testing.T) {
 			4,
 			false,
 		},
+		{
+			"SuccessAfterIndexUpdate",
+			func(api *mocks.DynamoDBAPI) {
+				api.On("DescribeTable", descriptionMock).Return(updatingIndexDescribeOutput, nil).Times(2)
+				api.On("DescribeTable", descriptionMock).Return(activeDescribeOutput, nil).Once()
+			},
+			2,
+			false,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -324,6 +570,39 @@ func TestBatchWrite(t *testing.T) {
 			getItems(defaultBatchInput),
 			true,
 		},
+		{
+			"AWSRequestLimitExceededError",
+			func(api *mocks.DynamoDBAPI) {
+				err := awserr.New("RequestLimitExceeded", "err", expectedError)
+				api.On("BatchWriteItem", &defaultBatchInput).Return(nil, err).Once()
+
+				api.On("BatchWriteItem", &defaultBatchInput).Return(&dynamodb.BatchWriteItemOutput{}, nil).Once()
+			},
+			getItems(defaultBatchInput),
+			false,
+		},
+		{
+			"AWSServiceUnavailableError",
+			func(api *mocks.DynamoDBAPI) {
+				err := awserr.New("ServiceUnavailable", "err", expectedError)
+				api.On("BatchWriteItem", &defaultBatchInput).Return(nil, err).Once()
+
+				api.On("BatchWriteItem", &defaultBatchInput).Return(&dynamodb.BatchWriteItemOutput{}, nil).Once()
+			},
+			getItems(defaultBatchInput),
+			false,
+		},
+		{
+			"ConnectionResetError",
+			func(api *mocks.DynamoDBAPI) {
+				err := awserr.New("RequestError", "send request failed", expectedError)
+				api.On("BatchWriteItem", &defaultBatchInput).Return(nil, err).Once()
+
+				api.On("BatchWriteItem", &defaultBatchInput).Return(&dynamodb.BatchWriteItemOutput{}, nil).Once()
+			},
+			getItems(defaultBatchInput),
+			false,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -351,6 +630,37 @@ func TestBatchWrite(t *testing.T) {
 	}
 }
 
+func TestBatchDelete(t *testing.T) {
+	t.Parallel()
+
+	keys := make([]dynamodbcopy.DynamoDBItem, 26)
+	requests := make([]*dynamodb.WriteRequest, len(keys))
+	for i := range keys {
+		keys[i] = dynamodbcopy.DynamoDBItem{"id": {S: aws.String(fmt.Sprintf("%d", i))}}
+		requests[i] = &dynamodb.WriteRequest{DeleteRequest: &dynamodb.DeleteRequest{Key: keys[i]}}
+	}
+
+	api := &mocks.DynamoDBAPI{}
+	for _, batch := range [][]*dynamodb.WriteRequest{requests[:25], requests[25:]} {
+		input := &dynamodb.BatchWriteItemInput{
+			RequestItems:           map[string][]*dynamodb.WriteRequest{expectedTableName: batch},
+			ReturnConsumedCapacity: aws.String(dynamodb.ReturnConsumedCapacityTotal),
+		}
+		api.On("BatchWriteItem", input).Return(&dynamodb.BatchWriteItemOutput{}, nil).Once()
+	}
+
+	service := dynamodbcopy.NewDynamoDBService(
+		expectedTableName,
+		api,
+		testSleeper,
+		log.New(ioutil.Discard, "", log.Ltime),
+	)
+
+	assert.Nil(t, service.BatchDelete(keys))
+
+	api.AssertExpectations(t)
+}
+
 func TestScan(t *testing.T) {
 	t.Parallel()
 
@@ -424,6 +734,300 @@ func TestScan(t *testing.T) {
 	}
 }
 
+func TestScanWithOptions(t *testing.T) {
+	t.Parallel()
+
+	input := buildScanInput(2, 1)
+	input.ProjectionExpression = aws.String("#projection0, #projection1")
+	input.FilterExpression = aws.String("#tenant = :tenant")
+	input.ExpressionAttributeNames = map[string]*string{
+		"#projection0": aws.String("id"),
+		"#projection1": aws.String("sort"),
+		"#tenant":      aws.String("tenant"),
+	}
+	input.ExpressionAttributeValues = map[string]*dynamodb.AttributeValue{":tenant": {S: aws.String("x")}}
+
+	api := &mocks.DynamoDBAPI{}
+	api.On("ScanPages", input, mock.Anything).Return(nil).Once()
+
+	service := dynamodbcopy.NewDynamoDBService(
+		expectedTableName,
+		api,
+		testSleeper,
+		log.New(ioutil.Discard, "", log.Ltime),
+	)
+
+	options := dynamodbcopy.ScanOptions{
+		ProjectionAttributes:      []string{"id", "sort"},
+		FilterExpression:          "#tenant = :tenant",
+		ExpressionAttributeNames:  map[string]*string{"#tenant": aws.String("tenant")},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{":tenant": {S: aws.String("x")}},
+	}
+	err := service.ScanWithOptions(options, 2, 1, make(chan []dynamodbcopy.DynamoDBItem))
+
+	assert.Nil(t, err)
+
+	api.AssertExpectations(t)
+}
+
+func TestQuery(t *testing.T) {
+	t.Parallel()
+
+	input := &dynamodb.QueryInput{
+		TableName:                 aws.String(expectedTableName),
+		IndexName:                 aws.String("tenant-index"),
+		KeyConditionExpression:    aws.String("tenant = :tenant"),
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{":tenant": {S: aws.String("x")}},
+		ReturnConsumedCapacity:    aws.String(dynamodb.ReturnConsumedCapacityTotal),
+	}
+	items := []map[string]*dynamodb.AttributeValue{{"id": {S: aws.String("1")}}}
+
+	api := &mocks.DynamoDBAPI{}
+	api.On("QueryPages", input, mock.Anything).
+		Run(func(args mock.Arguments) {
+			pager := args.Get(1).(func(*dynamodb.QueryOutput, bool) bool)
+			pager(&dynamodb.QueryOutput{Items: items}, true)
+		}).
+		Return(nil).
+		Once()
+
+	service := dynamodbcopy.NewDynamoDBService(
+		expectedTableName,
+		api,
+		testSleeper,
+		log.New(ioutil.Discard, "", log.Ltime),
+	)
+
+	options := dynamodbcopy.ScanOptions{
+		IndexName:                 "tenant-index",
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{":tenant": {S: aws.String("x")}},
+	}
+	itemsChan := make(chan []dynamodbcopy.DynamoDBItem, 1)
+	err := service.Query("tenant = :tenant", options, itemsChan)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []dynamodbcopy.DynamoDBItem{items[0]}, <-itemsChan)
+
+	api.AssertExpectations(t)
+}
+
+func TestIsEmpty(t *testing.T) {
+	t.Parallel()
+
+	input := &dynamodb.ScanInput{TableName: aws.String(expectedTableName), Limit: aws.Int64(1)}
+	item := map[string]*dynamodb.AttributeValue{"id": {S: aws.String("1")}}
+
+	testCases := []struct {
+		subTestName   string
+		output        *dynamodb.ScanOutput
+		err           error
+		expectedEmpty bool
+	}{
+		{"Error", nil, errors.New("scan error"), false},
+		{"Empty", &dynamodb.ScanOutput{}, nil, true},
+		{"NotEmpty", &dynamodb.ScanOutput{Items: []map[string]*dynamodb.AttributeValue{item}}, nil, false},
+		{"NotEmptyWithoutMatches", &dynamodb.ScanOutput{LastEvaluatedKey: item}, nil, false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.subTestName,
+			func(st *testing.T) {
+				api := &mocks.DynamoDBAPI{}
+				api.On("Scan", input).Return(testCase.output, testCase.err).Once()
+
+				service := dynamodbcopy.NewDynamoDBService(
+					expectedTableName,
+					api,
+					testSleeper,
+					log.New(ioutil.Discard, "", log.Ltime),
+				)
+
+				empty, err := service.IsEmpty()
+
+				assertExpectedError(st, testCase.err != nil, err)
+				assert.Equal(st, testCase.expectedEmpty, empty)
+
+				api.AssertExpectations(st)
+			},
+		)
+	}
+}
+
+func TestTags(t *testing.T) {
+	t.Parallel()
+
+	tableArn := aws.String("arn:aws:dynamodb:eu-west-1:111111111111:table/" + expectedTableName)
+
+	api := &mocks.DynamoDBAPI{}
+	api.On("DescribeTable", &dynamodb.DescribeTableInput{TableName: aws.String(expectedTableName)}).
+		Return(&dynamodb.DescribeTableOutput{Table: &dynamodb.TableDescription{TableArn: tableArn}}, nil).
+		Once()
+	api.On("ListTagsOfResource", &dynamodb.ListTagsOfResourceInput{ResourceArn: tableArn}).
+		Return(
+			&dynamodb.ListTagsOfResourceOutput{
+				Tags:      []*dynamodb.Tag{{Key: aws.String("env"), Value: aws.String("staging")}},
+				NextToken: aws.String("next"),
+			},
+			nil,
+		).
+		Once()
+	api.On("ListTagsOfResource", &dynamodb.ListTagsOfResourceInput{ResourceArn: tableArn, NextToken: aws.String("next")}).
+		Return(
+			&dynamodb.ListTagsOfResourceOutput{
+				Tags: []*dynamodb.Tag{{Key: aws.String("team"), Value: aws.String("data")}},
+			},
+			nil,
+		).
+		Once()
+
+	service := dynamodbcopy.NewDynamoDBService(
+		expectedTableName,
+		api,
+		testSleeper,
+		log.New(ioutil.Discard, "", log.Ltime),
+	)
+
+	tags, err := service.Tags()
+
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"env": "staging", "team": "data"}, tags)
+
+	api.AssertExpectations(t)
+}
+
+func TestConditionalPut(t *testing.T) {
+	t.Parallel()
+
+	item := dynamodbcopy.DynamoDBItem{"id": {S: aws.String("1")}}
+	condition := dynamodbcopy.WriteCondition{
+		Expression: "attribute_not_exists(#key)",
+		Names:      map[string]*string{"#key": aws.String("id")},
+	}
+	input := &dynamodb.PutItemInput{
+		TableName:                aws.String(expectedTableName),
+		Item:                     item,
+		ConditionExpression:      aws.String(condition.Expression),
+		ExpressionAttributeNames: condition.Names,
+		ReturnConsumedCapacity:   aws.String(dynamodb.ReturnConsumedCapacityTotal),
+	}
+
+	expectedError := errors.New("put error")
+
+	testCases := []struct {
+		subTestName     string
+		mocker          func(api *mocks.DynamoDBAPI)
+		expectedWritten bool
+		errorExpected   bool
+	}{
+		{
+			"Error",
+			func(api *mocks.DynamoDBAPI) {
+				api.On("PutItem", input).Return(nil, expectedError).Once()
+			},
+			false,
+			true,
+		},
+		{
+			"ConditionFailed",
+			func(api *mocks.DynamoDBAPI) {
+				err := awserr.New(dynamodb.ErrCodeConditionalCheckFailedException, "err", expectedError)
+				api.On("PutItem", input).Return(nil, err).Once()
+			},
+			false,
+			false,
+		},
+		{
+			"AWSProvisioningError",
+			func(api *mocks.DynamoDBAPI) {
+				err := awserr.New(dynamodb.ErrCodeProvisionedThroughputExceededException, "err", expectedError)
+				api.On("PutItem", input).Return(nil, err).Once()
+				api.On("PutItem", input).Return(&dynamodb.PutItemOutput{}, nil).Once()
+			},
+			true,
+			false,
+		},
+		{
+			"Written",
+			func(api *mocks.DynamoDBAPI) {
+				api.On("PutItem", input).Return(&dynamodb.PutItemOutput{}, nil).Once()
+			},
+			true,
+			false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.subTestName,
+			func(st *testing.T) {
+				api := &mocks.DynamoDBAPI{}
+
+				testCase.mocker(api)
+
+				service := dynamodbcopy.NewDynamoDBService(
+					expectedTableName,
+					api,
+					testSleeper,
+					log.New(ioutil.Discard, "", log.Ltime),
+				)
+
+				written, err := service.ConditionalPut(item, condition)
+
+				assertExpectedError(st, testCase.errorExpected, err)
+				assert.Equal(st, testCase.expectedWritten, written)
+
+				api.AssertExpectations(st)
+			},
+		)
+	}
+}
+
+func TestConsumedCapacity(t *testing.T) {
+	t.Parallel()
+
+	api := &mocks.DynamoDBAPI{}
+
+	batchInput := buildBatchWriteItemInput(10)
+	batchOutput := &dynamodb.BatchWriteItemOutput{
+		ConsumedCapacity: []*dynamodb.ConsumedCapacity{
+			{TableName: aws.String(expectedTableName), CapacityUnits: aws.Float64(10)},
+		},
+	}
+	api.On("BatchWriteItem", &batchInput).Return(batchOutput, nil).Twice()
+
+	scanOutput := &dynamodb.ScanOutput{
+		ConsumedCapacity: &dynamodb.ConsumedCapacity{
+			TableName:     aws.String(expectedTableName),
+			CapacityUnits: aws.Float64(2.5),
+		},
+	}
+	api.On("ScanPages", buildScanInput(1, 0), mock.Anything).
+		Run(func(args mock.Arguments) {
+			pager := args.Get(1).(func(*dynamodb.ScanOutput, bool) bool)
+			pager(scanOutput, true)
+		}).
+		Return(nil).
+		Once()
+
+	service := dynamodbcopy.NewDynamoDBService(
+		expectedTableName,
+		api,
+		testSleeper,
+		log.New(ioutil.Discard, "", log.Ltime),
+	)
+
+	require.Nil(t, service.BatchWrite(getItems(batchInput)))
+	require.Nil(t, service.BatchWrite(getItems(batchInput)))
+
+	itemsChan := make(chan []dynamodbcopy.DynamoDBItem, 1)
+	require.Nil(t, service.Scan(1, 0, itemsChan))
+
+	assert.Equal(t, dynamodbcopy.ConsumedCapacity{Read: 2.5, Write: 20}, service.ConsumedCapacity())
+
+	api.AssertExpectations(t)
+}
+
 func assertExpectedError(t *testing.T, errorExpected bool, err error) {
 	if errorExpected {
 		require.NotNil(t, err)
@@ -432,17 +1036,342 @@ func assertExpectedError(t *testing.T, errorExpected bool, err error) {
 	}
 }
 
+func TestTimeToLive(t *testing.T) {
+	t.Parallel()
+
+	input := &dynamodb.DescribeTimeToLiveInput{TableName: aws.String(expectedTableName)}
+	description := &dynamodb.TimeToLiveDescription{
+		AttributeName:    aws.String("expiresAt"),
+		TimeToLiveStatus: aws.String(dynamodb.TimeToLiveStatusEnabled),
+	}
+
+	api := &mocks.DynamoDBAPI{}
+	api.On("DescribeTimeToLive", input).
+		Return(&dynamodb.DescribeTimeToLiveOutput{TimeToLiveDescription: description}, nil).
+		Once()
+	api.On("DescribeTimeToLive", input).Return(nil, errors.New("describe error")).Once()
+
+	service := dynamodbcopy.NewDynamoDBService(expectedTableName, api, testSleeper, log.New(ioutil.Discard, "", 0))
+
+	timeToLive, err := service.TimeToLive()
+
+	require.Nil(t, err)
+	assert.Equal(t, description, timeToLive)
+
+	_, err = service.TimeToLive()
+
+	assert.NotNil(t, err)
+
+	api.AssertExpectations(t)
+}
+
+func TestPointInTimeRecovery(t *testing.T) {
+	t.Parallel()
+
+	input := &dynamodb.DescribeContinuousBackupsInput{TableName: aws.String(expectedTableName)}
+	output := &dynamodb.DescribeContinuousBackupsOutput{
+		ContinuousBackupsDescription: &dynamodb.ContinuousBackupsDescription{
+			ContinuousBackupsStatus: aws.String(dynamodb.ContinuousBackupsStatusEnabled),
+			PointInTimeRecoveryDescription: &dynamodb.PointInTimeRecoveryDescription{
+				PointInTimeRecoveryStatus: aws.String(dynamodb.PointInTimeRecoveryStatusEnabled),
+			},
+		},
+	}
+
+	api := &mocks.DynamoDBAPI{}
+	api.On("DescribeContinuousBackups", input).Return(output, nil).Once()
+	api.On("DescribeContinuousBackups", input).Return(&dynamodb.DescribeContinuousBackupsOutput{}, nil).Once()
+	api.On("DescribeContinuousBackups", input).Return(nil, errors.New("describe error")).Once()
+
+	service := dynamodbcopy.NewDynamoDBService(expectedTableName, api, testSleeper, log.New(ioutil.Discard, "", 0))
+
+	enabled, err := service.PointInTimeRecovery()
+
+	require.Nil(t, err)
+	assert.True(t, enabled)
+
+	enabled, err = service.PointInTimeRecovery()
+
+	require.Nil(t, err)
+	assert.False(t, enabled)
+
+	_, err = service.PointInTimeRecovery()
+
+	assert.NotNil(t, err)
+
+	api.AssertExpectations(t)
+}
+
+func TestContributorInsights(t *testing.T) {
+	t.Parallel()
+
+	input := &dynamodb.DescribeContributorInsightsInput{TableName: aws.String(expectedTableName)}
+
+	api := &mocks.DynamoDBAPI{}
+	api.On("DescribeContributorInsights", input).
+		Return(
+			&dynamodb.DescribeContributorInsightsOutput{
+				ContributorInsightsStatus: aws.String(dynamodb.ContributorInsightsStatusEnabled),
+			},
+			nil,
+		).
+		Once()
+	api.On("DescribeContributorInsights", input).
+		Return(
+			&dynamodb.DescribeContributorInsightsOutput{
+				ContributorInsightsStatus: aws.String(dynamodb.ContributorInsightsStatusDisabled),
+			},
+			nil,
+		).
+		Once()
+	api.On("DescribeContributorInsights", input).Return(nil, errors.New("describe error")).Once()
+
+	service := dynamodbcopy.NewDynamoDBService(expectedTableName, api, testSleeper, log.New(ioutil.Discard, "", 0))
+
+	enabled, err := service.ContributorInsights()
+
+	require.Nil(t, err)
+	assert.True(t, enabled)
+
+	enabled, err = service.ContributorInsights()
+
+	require.Nil(t, err)
+	assert.False(t, enabled)
+
+	_, err = service.ContributorInsights()
+
+	assert.NotNil(t, err)
+
+	api.AssertExpectations(t)
+}
+
+func TestUpdateSettings(t *testing.T) {
+	t.Parallel()
+
+	tableArn := aws.String("arn:aws:dynamodb:eu-west-1:111111111111:table/" + expectedTableName)
+	stream := &dynamodb.StreamSpecification{
+		StreamEnabled:  aws.Bool(true),
+		StreamViewType: aws.String(dynamodb.StreamViewTypeNewAndOldImages),
+	}
+
+	api := &mocks.DynamoDBAPI{}
+	api.On(
+		"UpdateTimeToLive",
+		&dynamodb.UpdateTimeToLiveInput{
+			TableName: aws.String(expectedTableName),
+			TimeToLiveSpecification: &dynamodb.TimeToLiveSpecification{
+				AttributeName: aws.String("expiresAt"),
+				Enabled:       aws.Bool(true),
+			},
+		},
+	).Return(&dynamodb.UpdateTimeToLiveOutput{}, nil).Once()
+	api.On(
+		"UpdateContinuousBackups",
+		&dynamodb.UpdateContinuousBackupsInput{
+			TableName: aws.String(expectedTableName),
+			PointInTimeRecoverySpecification: &dynamodb.PointInTimeRecoverySpecification{
+				PointInTimeRecoveryEnabled: aws.Bool(true),
+			},
+		},
+	).Return(&dynamodb.UpdateContinuousBackupsOutput{}, nil).Once()
+	api.On(
+		"UpdateTable",
+		&dynamodb.UpdateTableInput{TableName: aws.String(expectedTableName), StreamSpecification: stream},
+	).Return(&dynamodb.UpdateTableOutput{}, nil).Once()
+	api.On(
+		"UpdateContributorInsights",
+		&dynamodb.UpdateContributorInsightsInput{
+			TableName:                 aws.String(expectedTableName),
+			ContributorInsightsAction: aws.String(dynamodb.ContributorInsightsActionEnable),
+		},
+	).Return(&dynamodb.UpdateContributorInsightsOutput{}, nil).Once()
+	api.On(
+		"UpdateTable",
+		&dynamodb.UpdateTableInput{TableName: aws.String(expectedTableName), DeletionProtectionEnabled: aws.Bool(true)},
+	).Return(&dynamodb.UpdateTableOutput{}, nil).Once()
+	api.On("DescribeTable", &dynamodb.DescribeTableInput{TableName: aws.String(expectedTableName)}).
+		Return(buildDescribeTableOutput(expectedTableName, dynamodb.TableStatusActive), nil).
+		Twice()
+	api.On("DescribeTable", &dynamodb.DescribeTableInput{TableName: aws.String(expectedTableName)}).
+		Return(&dynamodb.DescribeTableOutput{Table: &dynamodb.TableDescription{TableArn: tableArn}}, nil).
+		Once()
+	api.On(
+		"TagResource",
+		&dynamodb.TagResourceInput{
+			ResourceArn: tableArn,
+			Tags: []*dynamodb.Tag{
+				{Key: aws.String("env"), Value: aws.String("staging")},
+				{Key: aws.String("team"), Value: aws.String("data")},
+			},
+		},
+	).Return(&dynamodb.TagResourceOutput{}, nil).Once()
+
+	service := dynamodbcopy.NewDynamoDBService(expectedTableName, api, testSleeper, log.New(ioutil.Discard, "", 0))
+
+	require.Nil(t, service.UpdateTimeToLive("expiresAt", true))
+	require.Nil(t, service.UpdatePointInTimeRecovery(true))
+	require.Nil(t, service.UpdateStream(stream))
+	require.Nil(t, service.UpdateContributorInsights(true))
+	require.Nil(t, service.UpdateDeletionProtection(true))
+	require.Nil(t, service.TagTable(map[string]string{"team": "data", "env": "staging"}))
+
+	api.AssertExpectations(t)
+}
+
+func TestUpdateSettingsError(t *testing.T) {
+	t.Parallel()
+
+	expectedError := errors.New("update settings error")
+
+	api := &mocks.DynamoDBAPI{}
+	api.On("UpdateTimeToLive", mock.Anything).Return(nil, expectedError).Once()
+	api.On("UpdateContinuousBackups", mock.Anything).Return(nil, expectedError).Once()
+	api.On("UpdateTable", mock.Anything).Return(nil, expectedError).Twice()
+	api.On("UpdateContributorInsights", mock.Anything).Return(nil, expectedError).Once()
+	api.On("DescribeTable", mock.Anything).Return(nil, expectedError).Once()
+
+	service := dynamodbcopy.NewDynamoDBService(expectedTableName, api, testSleeper, log.New(ioutil.Discard, "", 0))
+
+	assert.NotNil(t, service.UpdateTimeToLive("expiresAt", true))
+	assert.NotNil(t, service.UpdatePointInTimeRecovery(true))
+	assert.NotNil(t, service.UpdateStream(&dynamodb.StreamSpecification{StreamEnabled: aws.Bool(false)}))
+	assert.NotNil(t, service.UpdateContributorInsights(false))
+	assert.NotNil(t, service.UpdateDeletionProtection(false))
+	assert.NotNil(t, service.TagTable(map[string]string{"team": "data"}))
+
+	api.AssertExpectations(t)
+}
+
+func TestCount(t *testing.T) {
+	t.Parallel()
+
+	input := buildScanInput(2, 1)
+	input.Select = aws.String(dynamodb.SelectCount)
+	input.FilterExpression = aws.String("#tenant = :tenant")
+	input.ExpressionAttributeNames = map[string]*string{"#tenant": aws.String("tenant")}
+	input.ExpressionAttributeValues = map[string]*dynamodb.AttributeValue{":tenant": {S: aws.String("x")}}
+
+	api := &mocks.DynamoDBAPI{}
+	api.On("ScanPages", input, mock.Anything).
+		Run(func(args mock.Arguments) {
+			pager := args.Get(1).(func(*dynamodb.ScanOutput, bool) bool)
+			pager(&dynamodb.ScanOutput{Count: aws.Int64(3)}, false)
+			pager(&dynamodb.ScanOutput{Count: aws.Int64(2)}, true)
+		}).
+		Return(nil).
+		Once()
+
+	service := dynamodbcopy.NewDynamoDBService(
+		expectedTableName,
+		api,
+		testSleeper,
+		log.New(ioutil.Discard, "", log.Ltime),
+	)
+
+	options := dynamodbcopy.ScanOptions{
+		FilterExpression:          "#tenant = :tenant",
+		ExpressionAttributeNames:  map[string]*string{"#tenant": aws.String("tenant")},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{":tenant": {S: aws.String("x")}},
+	}
+	count, err := service.Count(options, 2, 1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), count)
+
+	_, err = service.Count(options, 0, 0)
+
+	assert.NotNil(t, err)
+
+	api.AssertExpectations(t)
+}
+
+func TestScanRetry(t *testing.T) {
+	t.Parallel()
+
+	lastKey := map[string]*dynamodb.AttributeValue{"id": {S: aws.String("last")}}
+	item := map[string]*dynamodb.AttributeValue{"id": {S: aws.String("item")}}
+
+	firstCall := mock.MatchedBy(func(input *dynamodb.ScanInput) bool {
+		return input.ExclusiveStartKey == nil
+	})
+	resumedCall := mock.MatchedBy(func(input *dynamodb.ScanInput) bool {
+		return aws.StringValue(input.ExclusiveStartKey["id"].S) == "last"
+	})
+
+	firstPage := func(args mock.Arguments) {
+		pager := args.Get(1).(func(*dynamodb.ScanOutput, bool) bool)
+		pager(&dynamodb.ScanOutput{Items: []map[string]*dynamodb.AttributeValue{item}, LastEvaluatedKey: lastKey}, false)
+	}
+	lastPage := func(args mock.Arguments) {
+		pager := args.Get(1).(func(*dynamodb.ScanOutput, bool) bool)
+		pager(&dynamodb.ScanOutput{Items: []map[string]*dynamodb.AttributeValue{item}}, true)
+	}
+
+	testCases := []struct {
+		subTestName   string
+		err           error
+		expectedPages int
+		errorExpected bool
+	}{
+		{
+			"ProvisionedThroughputExceeded",
+			awserr.New(dynamodb.ErrCodeProvisionedThroughputExceededException, "err", nil),
+			2,
+			false,
+		},
+		{"Throttling", awserr.New("ThrottlingException", "err", nil), 2, false},
+		{"InternalServerError", awserr.New(dynamodb.ErrCodeInternalServerError, "err", nil), 2, false},
+		{"ConnectionReset", awserr.New("RequestError", "send request failed", errors.New("connection reset")), 2, false},
+		{"FatalAWSError", awserr.New(dynamodb.ErrCodeResourceNotFoundException, "err", nil), 1, true},
+		{"FatalError", errors.New("scan error"), 1, true},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(
+			testCase.subTestName,
+			func(st *testing.T) {
+				api := &mocks.DynamoDBAPI{}
+				api.On("ScanPages", firstCall, mock.Anything).
+					Run(firstPage).
+					Return(testCase.err).
+					Once()
+				if !testCase.errorExpected {
+					api.On("ScanPages", resumedCall, mock.Anything).Run(lastPage).Return(nil).Once()
+				}
+
+				service := dynamodbcopy.NewDynamoDBService(
+					expectedTableName,
+					api,
+					testSleeper,
+					log.New(ioutil.Discard, "", log.Ltime),
+				)
+
+				itemsChan := make(chan []dynamodbcopy.DynamoDBItem, 2)
+				err := service.Scan(1, 0, itemsChan)
+
+				assertExpectedError(st, testCase.errorExpected, err)
+				assert.Len(st, itemsChan, testCase.expectedPages)
+
+				api.AssertExpectations(st)
+			},
+		)
+	}
+}
+
 func buildScanInput(totalSegments, segment int64) *dynamodb.ScanInput {
 	if totalSegments < 2 {
 		return &dynamodb.ScanInput{
-			TableName: aws.String(expectedTableName),
+			TableName:              aws.String(expectedTableName),
+			ReturnConsumedCapacity: aws.String(dynamodb.ReturnConsumedCapacityTotal),
 		}
 	}
 
 	return &dynamodb.ScanInput{
-		TableName:     aws.String(expectedTableName),
-		TotalSegments: aws.Int64(totalSegments),
-		Segment:       aws.Int64(segment),
+		TableName:              aws.String(expectedTableName),
+		TotalSegments:          aws.Int64(totalSegments),
+		Segment:                aws.Int64(segment),
+		ReturnConsumedCapacity: aws.String(dynamodb.ReturnConsumedCapacityTotal),
 	}
 }
 
@@ -465,7 +1394,8 @@ func buildBatchWriteItemInput(itemCount int) dynamodb.BatchWriteItemInput {
 	items[expectedTableName] = requests
 
 	return dynamodb.BatchWriteItemInput{
-		RequestItems: items,
+		RequestItems:           items,
+		ReturnConsumedCapacity: aws.String(dynamodb.ReturnConsumedCapacityTotal),
 	}
 }
 
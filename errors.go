@@ -0,0 +1,93 @@
+package dynamodbcopy
+
+import (
+	"errors"
+	"net"
+	"syscall"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+// ErrorClass is the way the failed requests to DynamoDB are handled
+type ErrorClass int
+
+// Error classes
+const (
+	// ErrorFatal errors fail the operation right away
+	ErrorFatal ErrorClass = iota
+	// ErrorThrottled errors are retried, after backing off, since the table or account limits were exceeded
+	ErrorThrottled
+	// ErrorRetryable errors are transient server or network errors, retried after backing off
+	ErrorRetryable
+	// ErrorCredentials errors are caused by expired credentials, retried once the credentials are refreshed
+	ErrorCredentials
+)
+
+var errorClassNames = map[ErrorClass]string{
+	ErrorFatal:       "fatal",
+	ErrorThrottled:   "throttled",
+	ErrorRetryable:   "retryable",
+	ErrorCredentials: "credentials",
+}
+
+// String returns the name of the error class
+func (c ErrorClass) String() string {
+	return errorClassNames[c]
+}
+
+// Error codes that aren't defined by the dynamodb package
+const (
+	errCodeThrottlingException   = "ThrottlingException"
+	errCodeRequestLimitExceeded  = "RequestLimitExceeded"
+	errCodeServiceUnavailable    = "ServiceUnavailable"
+	errCodeExpiredToken          = "ExpiredToken"
+	errCodeExpiredTokenException = "ExpiredTokenException"
+	// errCodeRequestError is the code of the errors sending a request, e.g. connection resets
+	errCodeRequestError = "RequestError"
+)
+
+type errorClassification struct {
+	class  ErrorClass
+	reason string
+}
+
+var errorCodeClassifications = map[string]errorClassification{
+	dynamodb.ErrCodeProvisionedThroughputExceededException: {ErrorThrottled, "provisioned_throughput"},
+	errCodeThrottlingException:                             {ErrorThrottled, "throttling"},
+	errCodeRequestLimitExceeded:                            {ErrorThrottled, "request_limit_exceeded"},
+	dynamodb.ErrCodeInternalServerError:                    {ErrorRetryable, "internal_server_error"},
+	errCodeServiceUnavailable:                              {ErrorRetryable, "service_unavailable"},
+	errCodeRequestError:                                    {ErrorRetryable, "request_error"},
+	errCodeExpiredToken:                                    {ErrorCredentials, "expired_credentials"},
+	errCodeExpiredTokenException:                           {ErrorCredentials, "expired_credentials"},
+}
+
+// ClassifyError returns the class of an error returned by a DynamoDB request, with a short reason (e.g. throttling
+// or internal_server_error) for the errors that aren't fatal.
+//
+// Besides the AWS error codes, connection resets and network timeouts are retryable
+func ClassifyError(err error) (ErrorClass, string) {
+	if err == nil {
+		return ErrorFatal, ""
+	}
+
+	if awsErr, ok := err.(awserr.Error); ok {
+		if classification, ok := errorCodeClassifications[awsErr.Code()]; ok {
+			return classification.class, classification.reason
+		}
+
+		return ErrorFatal, ""
+	}
+
+	if errors.Is(err, syscall.ECONNRESET) {
+		return ErrorRetryable, "connection_reset"
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return ErrorRetryable, "timeout"
+	}
+
+	return ErrorFatal, ""
+}
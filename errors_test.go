@@ -0,0 +1,99 @@
+package dynamodbcopy_test
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"syscall"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/uniplaces/dynamodbcopy"
+)
+
+func TestClassifyError(t *testing.T) {
+	t.Parallel()
+
+	timeoutErr := &net.OpError{Op: "read", Net: "tcp", Err: &timeoutError{}}
+
+	testCases := []struct {
+		subTestName    string
+		err            error
+		expectedClass  dynamodbcopy.ErrorClass
+		expectedReason string
+	}{
+		{"Nil", nil, dynamodbcopy.ErrorFatal, ""},
+		{"Generic", errors.New("error"), dynamodbcopy.ErrorFatal, ""},
+		{
+			"ResourceNotFound",
+			awserr.New(dynamodb.ErrCodeResourceNotFoundException, "err", nil),
+			dynamodbcopy.ErrorFatal,
+			"",
+		},
+		{
+			"ProvisionedThroughputExceeded",
+			awserr.New(dynamodb.ErrCodeProvisionedThroughputExceededException, "err", nil),
+			dynamodbcopy.ErrorThrottled,
+			"provisioned_throughput",
+		},
+		{"Throttling", awserr.New("ThrottlingException", "err", nil), dynamodbcopy.ErrorThrottled, "throttling"},
+		{
+			"RequestLimitExceeded",
+			awserr.New("RequestLimitExceeded", "err", nil),
+			dynamodbcopy.ErrorThrottled,
+			"request_limit_exceeded",
+		},
+		{
+			"InternalServerError",
+			awserr.New(dynamodb.ErrCodeInternalServerError, "err", nil),
+			dynamodbcopy.ErrorRetryable,
+			"internal_server_error",
+		},
+		{
+			"ServiceUnavailable",
+			awserr.New("ServiceUnavailable", "err", nil),
+			dynamodbcopy.ErrorRetryable,
+			"service_unavailable",
+		},
+		{
+			"RequestError",
+			awserr.New("RequestError", "send request failed", syscall.ECONNRESET),
+			dynamodbcopy.ErrorRetryable,
+			"request_error",
+		},
+		{
+			"ExpiredToken",
+			awserr.New("ExpiredTokenException", "err", nil),
+			dynamodbcopy.ErrorCredentials,
+			"expired_credentials",
+		},
+		{
+			"ConnectionReset",
+			fmt.Errorf("read: %w", syscall.ECONNRESET),
+			dynamodbcopy.ErrorRetryable,
+			"connection_reset",
+		},
+		{"Timeout", timeoutErr, dynamodbcopy.ErrorRetryable, "timeout"},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(
+			testCase.subTestName,
+			func(st *testing.T) {
+				class, reason := dynamodbcopy.ClassifyError(testCase.err)
+
+				assert.Equal(st, testCase.expectedClass, class)
+				assert.Equal(st, testCase.expectedReason, reason)
+			},
+		)
+	}
+}
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
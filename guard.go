@@ -0,0 +1,225 @@
+package dynamodbcopy
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+// ErrNotConfirmed is returned by a Guard when the copy isn't confirmed
+var ErrNotConfirmed = errors.New("copy not confirmed")
+
+// Confirmer asks for the confirmation of the given message, returning true if the message was confirmed
+type Confirmer func(message string) (bool, error)
+
+// NewConfirmer returns a Confirmer that prompts the message into out, reading the answer (y or yes) from in
+func NewConfirmer(in io.Reader, out io.Writer) Confirmer {
+	reader := bufio.NewReader(in)
+
+	return func(message string) (bool, error) {
+		if _, err := fmt.Fprintf(out, "%s [y/N]: ", message); err != nil {
+			return false, err
+		}
+
+		answer, err := reader.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return false, err
+		}
+
+		switch strings.ToLower(strings.TrimSpace(answer)) {
+		case "y", "yes":
+			return true, nil
+		default:
+			return false, nil
+		}
+	}
+}
+
+// Guard is the interface that checks that a copy is safe to perform before touching the target table
+type Guard interface {
+	Check() error
+}
+
+type guardService struct {
+	srcTable          DynamoDBService
+	trgTable          DynamoDBService
+	force             bool
+	protectedPatterns []string
+	requiredTags      map[string]string
+	confirm           Confirmer
+}
+
+// NewGuard returns a new Guard for a copy between the given tables.
+//
+// Copies into a non-empty target table are only allowed with force, target table names matching any of the
+// protected patterns (path.Match syntax) are refused and the target table must have all the required tags.
+// A nil confirm skips the confirmation
+func NewGuard(
+	srcTableService, trgTableService DynamoDBService,
+	force bool,
+	protectedPatterns []string,
+	requiredTags map[string]string,
+	confirm Confirmer,
+) Guard {
+	return guardService{
+		srcTable:          srcTableService,
+		trgTable:          trgTableService,
+		force:             force,
+		protectedPatterns: protectedPatterns,
+		requiredTags:      requiredTags,
+		confirm:           confirm,
+	}
+}
+
+// Check returns an error if the copy is not safe to perform or if it isn't confirmed
+func (service guardService) Check() error {
+	srcDescription, err := service.srcTable.DescribeTable()
+	if err != nil {
+		return err
+	}
+
+	trgDescription, err := service.trgTable.DescribeTable()
+	if err != nil {
+		return err
+	}
+
+	srcArn, trgArn := aws.StringValue(srcDescription.TableArn), aws.StringValue(trgDescription.TableArn)
+	if srcArn == trgArn {
+		return fmt.Errorf("source and target are the same table (%s)", srcArn)
+	}
+
+	trgTableName := aws.StringValue(trgDescription.TableName)
+	for _, pattern := range service.protectedPatterns {
+		matched, err := path.Match(pattern, trgTableName)
+		if err != nil {
+			return fmt.Errorf("invalid protected table pattern %q: %s", pattern, err)
+		}
+
+		if matched {
+			return fmt.Errorf("target table %s is protected (%s)", trgTableName, pattern)
+		}
+	}
+
+	if err := service.checkRequiredTags(trgTableName); err != nil {
+		return err
+	}
+
+	if !service.force {
+		empty, err := service.trgTable.IsEmpty()
+		if err != nil {
+			return err
+		}
+
+		if !empty {
+			return fmt.Errorf("target table %s is not empty, use force to copy into it anyway", trgTableName)
+		}
+	}
+
+	if service.confirm == nil {
+		return nil
+	}
+
+	confirmed, err := service.confirm(
+		fmt.Sprintf("copy %s into %s?", describeTableLocation(srcDescription), describeTableLocation(trgDescription)),
+	)
+	if err != nil {
+		return err
+	}
+
+	if !confirmed {
+		return ErrNotConfirmed
+	}
+
+	return nil
+}
+
+func (service guardService) checkRequiredTags(trgTableName string) error {
+	if len(service.requiredTags) == 0 {
+		return nil
+	}
+
+	tags, err := service.trgTable.Tags()
+	if err != nil {
+		return err
+	}
+
+	for key, value := range service.requiredTags {
+		if tag, ok := tags[key]; !ok || tag != value {
+			return fmt.Errorf("target table %s is missing the required tag %s=%s", trgTableName, key, value)
+		}
+	}
+
+	return nil
+}
+
+type tableGuardService struct {
+	table   DynamoDBService
+	action  string
+	confirm Confirmer
+}
+
+// NewTableGuard returns a Guard for commands that change the items of a single table, asking for the confirmation
+// of the given action (e.g. "delete all items from") on the table. A nil confirm skips the confirmation
+func NewTableGuard(tableService DynamoDBService, action string, confirm Confirmer) Guard {
+	return tableGuardService{
+		table:   tableService,
+		action:  action,
+		confirm: confirm,
+	}
+}
+
+// Check returns an error if the action on the table isn't confirmed
+func (service tableGuardService) Check() error {
+	if service.confirm == nil {
+		return nil
+	}
+
+	description, err := service.table.DescribeTable()
+	if err != nil {
+		return err
+	}
+
+	confirmed, err := service.confirm(fmt.Sprintf("%s %s?", service.action, describeTableLocation(description)))
+	if err != nil {
+		return err
+	}
+
+	if !confirmed {
+		return ErrNotConfirmed
+	}
+
+	return nil
+}
+
+// describeTableLocation describes a table with its name, account ID and region
+func describeTableLocation(description *dynamodb.TableDescription) string {
+	tableName := aws.StringValue(description.TableName)
+
+	tableArn, err := arn.Parse(aws.StringValue(description.TableArn))
+	if err != nil {
+		return tableName
+	}
+
+	return fmt.Sprintf("table %s (account %s, region %s)", tableName, tableArn.AccountID, tableArn.Region)
+}
+
+// ParseTags parses tags in the key=value format
+func ParseTags(values []string) (map[string]string, error) {
+	tags := make(map[string]string, len(values))
+	for _, value := range values {
+		parts := strings.SplitN(value, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid tag %q: must be in the key=value format", value)
+		}
+		tags[parts[0]] = parts[1]
+	}
+
+	return tags, nil
+}
@@ -0,0 +1,240 @@
+package dynamodbcopy_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/uniplaces/dynamodbcopy"
+	"github.com/uniplaces/dynamodbcopy/mocks"
+)
+
+func TestGuardCheck(t *testing.T) {
+	t.Parallel()
+
+	srcDescription := buildArnTableDescription(srcTableName, "111111111111")
+	trgDescription := buildArnTableDescription(trgTableName, "222222222222")
+
+	expectedError := errors.New("guard error")
+
+	testCases := []struct {
+		subTestName       string
+		mocker            func(srcService, trgService *mocks.DynamoDBService)
+		force             bool
+		protectedPatterns []string
+		requiredTags      map[string]string
+		confirm           dynamodbcopy.Confirmer
+		expectedError     bool
+	}{
+		{
+			"DescribeError",
+			func(srcService, trgService *mocks.DynamoDBService) {
+				srcService.On("DescribeTable").Return(nil, expectedError).Once()
+			},
+			false,
+			nil,
+			nil,
+			nil,
+			true,
+		},
+		{
+			"SameTable",
+			func(srcService, trgService *mocks.DynamoDBService) {
+				srcService.On("DescribeTable").Return(&srcDescription, nil).Once()
+				trgService.On("DescribeTable").Return(&srcDescription, nil).Once()
+			},
+			true,
+			nil,
+			nil,
+			nil,
+			true,
+		},
+		{
+			"ProtectedTable",
+			func(srcService, trgService *mocks.DynamoDBService) {
+				srcService.On("DescribeTable").Return(&srcDescription, nil).Once()
+				trgService.On("DescribeTable").Return(&trgDescription, nil).Once()
+			},
+			true,
+			[]string{"prod-*", "trg-*"},
+			nil,
+			nil,
+			true,
+		},
+		{
+			"MissingRequiredTag",
+			func(srcService, trgService *mocks.DynamoDBService) {
+				srcService.On("DescribeTable").Return(&srcDescription, nil).Once()
+				trgService.On("DescribeTable").Return(&trgDescription, nil).Once()
+				trgService.On("Tags").Return(map[string]string{"env": "production"}, nil).Once()
+			},
+			true,
+			nil,
+			map[string]string{"env": "staging"},
+			nil,
+			true,
+		},
+		{
+			"NonEmptyTarget",
+			func(srcService, trgService *mocks.DynamoDBService) {
+				srcService.On("DescribeTable").Return(&srcDescription, nil).Once()
+				trgService.On("DescribeTable").Return(&trgDescription, nil).Once()
+				trgService.On("IsEmpty").Return(false, nil).Once()
+			},
+			false,
+			nil,
+			nil,
+			nil,
+			true,
+		},
+		{
+			"NotConfirmed",
+			func(srcService, trgService *mocks.DynamoDBService) {
+				srcService.On("DescribeTable").Return(&srcDescription, nil).Once()
+				trgService.On("DescribeTable").Return(&trgDescription, nil).Once()
+				trgService.On("IsEmpty").Return(true, nil).Once()
+			},
+			false,
+			nil,
+			nil,
+			func(message string) (bool, error) {
+				return false, nil
+			},
+			true,
+		},
+		{
+			"Success",
+			func(srcService, trgService *mocks.DynamoDBService) {
+				srcService.On("DescribeTable").Return(&srcDescription, nil).Once()
+				trgService.On("DescribeTable").Return(&trgDescription, nil).Once()
+				trgService.On("Tags").Return(map[string]string{"env": "staging"}, nil).Once()
+			},
+			true,
+			[]string{"prod-*"},
+			map[string]string{"env": "staging"},
+			func(message string) (bool, error) {
+				expected := "copy table src-table-name (account 111111111111, region eu-west-1) into " +
+					"table trg-table-name (account 222222222222, region eu-west-1)?"
+
+				return message == expected, nil
+			},
+			false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.subTestName,
+			func(st *testing.T) {
+				srcService := &mocks.DynamoDBService{}
+				trgService := &mocks.DynamoDBService{}
+
+				testCase.mocker(srcService, trgService)
+
+				guard := dynamodbcopy.NewGuard(
+					srcService,
+					trgService,
+					testCase.force,
+					testCase.protectedPatterns,
+					testCase.requiredTags,
+					testCase.confirm,
+				)
+
+				err := guard.Check()
+
+				assertExpectedError(st, testCase.expectedError, err)
+
+				srcService.AssertExpectations(st)
+				trgService.AssertExpectations(st)
+			},
+		)
+	}
+}
+
+func TestTableGuardCheck(t *testing.T) {
+	t.Parallel()
+
+	description := buildArnTableDescription(trgTableName, "222222222222")
+	expectedMessage := "delete all items from table trg-table-name (account 222222222222, region eu-west-1)?"
+
+	testCases := []struct {
+		subTestName   string
+		confirmed     bool
+		expectedError bool
+	}{
+		{"Confirmed", true, false},
+		{"NotConfirmed", false, true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.subTestName,
+			func(st *testing.T) {
+				service := &mocks.DynamoDBService{}
+				service.On("DescribeTable").Return(&description, nil).Once()
+
+				guard := dynamodbcopy.NewTableGuard(service, "delete all items from", func(message string) (bool, error) {
+					assert.Equal(st, expectedMessage, message)
+
+					return testCase.confirmed, nil
+				})
+
+				assertExpectedError(st, testCase.expectedError, guard.Check())
+
+				service.AssertExpectations(st)
+			},
+		)
+	}
+
+	assert.Nil(t, dynamodbcopy.NewTableGuard(&mocks.DynamoDBService{}, "delete all items from", nil).Check())
+}
+
+func TestNewConfirmer(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		answer   string
+		expected bool
+	}{
+		{"y\n", true},
+		{"YES\n", true},
+		{"n\n", false},
+		{"\n", false},
+		{"", false},
+	}
+
+	for _, testCase := range testCases {
+		out := &bytes.Buffer{}
+		confirm := dynamodbcopy.NewConfirmer(strings.NewReader(testCase.answer), out)
+
+		confirmed, err := confirm("copy?")
+
+		assert.Nil(t, err)
+		assert.Equal(t, testCase.expected, confirmed, testCase.answer)
+		assert.Equal(t, "copy? [y/N]: ", out.String())
+	}
+}
+
+func TestParseTags(t *testing.T) {
+	t.Parallel()
+
+	tags, err := dynamodbcopy.ParseTags([]string{"env=staging", "team=data=platform"})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"env": "staging", "team": "data=platform"}, tags)
+
+	for _, value := range []string{"env", "=staging"} {
+		_, err := dynamodbcopy.ParseTags([]string{value})
+		assert.NotNil(t, err, value)
+	}
+}
+
+func buildArnTableDescription(table, account string) dynamodb.TableDescription {
+	description := buildDefaultTableDescription(table)
+	description.TableArn = aws.String("arn:aws:dynamodb:eu-west-1:" + account + ":table/" + table)
+
+	return description
+}
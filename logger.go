@@ -1,17 +1,213 @@
 package dynamodbcopy
 
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
 // Logger defines the logging interface used by the command
 type Logger interface {
 	Printf(format string, msg ...interface{})
 }
 
+// Level is the severity of a log entry
+type Level int
+
+// Log levels, from the most to the least verbose
+const (
+	LevelDebug Level = iota
+	LevelInfo
+	LevelWarn
+	LevelError
+)
+
+var levelNames = map[Level]string{
+	LevelDebug: "debug",
+	LevelInfo:  "info",
+	LevelWarn:  "warn",
+	LevelError: "error",
+}
+
+// String returns the name of the level
+func (l Level) String() string {
+	return levelNames[l]
+}
+
+// ParseLevel parses a level name: debug, info, warn or error
+func ParseLevel(value string) (Level, error) {
+	for level, name := range levelNames {
+		if name == value {
+			return level, nil
+		}
+	}
+
+	return LevelInfo, fmt.Errorf("invalid log level %q: expected debug, info, warn or error", value)
+}
+
+// LogFormat is the output format of a LeveledLogger
+type LogFormat string
+
+// Log formats
+const (
+	LogFormatText LogFormat = "text"
+	LogFormatJSON LogFormat = "json"
+)
+
+// ParseLogFormat parses a log format: text or json
+func ParseLogFormat(value string) (LogFormat, error) {
+	switch format := LogFormat(value); format {
+	case LogFormatText, LogFormatJSON:
+		return format, nil
+	default:
+		return "", fmt.Errorf("invalid log format %q: expected text or json", value)
+	}
+}
+
+// Fields are the structured context of a log entry, e.g. table, segment, worker, attempt or elapsed
+type Fields map[string]interface{}
+
+// LeveledLogger is a Logger that logs entries with a level and structured fields.
+// Printf logs at the info level, keeping it compatible with the Logger interface
+type LeveledLogger interface {
+	Logger
+	Log(level Level, msg string, fields Fields)
+	With(fields Fields) LeveledLogger
+}
+
+type leveledLogger struct {
+	out    io.Writer
+	mutex  *sync.Mutex
+	format LogFormat
+	level  Level
+	fields Fields
+	now    func() time.Time
+}
+
+// NewLeveledLogger returns a new LeveledLogger writing the entries of at least the given level into out
+func NewLeveledLogger(out io.Writer, format LogFormat, level Level) LeveledLogger {
+	return leveledLogger{
+		out:    out,
+		mutex:  &sync.Mutex{},
+		format: format,
+		level:  level,
+		now:    time.Now,
+	}
+}
+
+// Printf logs a formatted message at the info level
+func (l leveledLogger) Printf(format string, msg ...interface{}) {
+	l.Log(LevelInfo, fmt.Sprintf(format, msg...), nil)
+}
+
+// Log logs a message with the given fields, added to the fields of the logger, unless its level is too low
+func (l leveledLogger) Log(level Level, msg string, fields Fields) {
+	if level < l.level {
+		return
+	}
+
+	entry := make(Fields, len(l.fields)+len(fields))
+	for name, value := range l.fields {
+		entry[name] = value
+	}
+	for name, value := range fields {
+		entry[name] = value
+	}
+
+	var line string
+	if l.format == LogFormatJSON {
+		line = l.jsonLine(level, msg, entry)
+	} else {
+		line = l.textLine(level, msg, entry)
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	fmt.Fprintln(l.out, line)
+}
+
+// With returns a LeveledLogger adding the given fields to all its entries
+func (l leveledLogger) With(fields Fields) LeveledLogger {
+	merged := make(Fields, len(l.fields)+len(fields))
+	for name, value := range l.fields {
+		merged[name] = value
+	}
+	for name, value := range fields {
+		merged[name] = value
+	}
+	l.fields = merged
+
+	return l
+}
+
+func (l leveledLogger) textLine(level Level, msg string, fields Fields) string {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	parts := []string{l.now().Format("2006/01/02 15:04:05"), strings.ToUpper(level.String()), msg}
+	for _, name := range names {
+		parts = append(parts, fmt.Sprintf("%s=%v", name, fields[name]))
+	}
+
+	return strings.Join(parts, " ")
+}
+
+func (l leveledLogger) jsonLine(level Level, msg string, fields Fields) string {
+	entry := make(map[string]interface{}, len(fields)+3)
+	for name, value := range fields {
+		entry[name] = value
+	}
+	entry["time"] = l.now().UTC().Format(time.RFC3339Nano)
+	entry["level"] = level.String()
+	entry["msg"] = msg
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Sprintf(`{"level":"error","msg":"unable to encode log entry: %s"}`, err)
+	}
+
+	return string(line)
+}
+
+// logWith logs a formatted message with the given level and fields when logger is a LeveledLogger.
+// Other loggers keep receiving the formatted message through Printf, warnings and errors even without debug
+func logWith(logger Logger, level Level, fields Fields, format string, msg ...interface{}) {
+	if debug, ok := logger.(debugLogger); ok && level >= LevelWarn {
+		logger = debug.Logger
+	}
+
+	if leveled, ok := logger.(LeveledLogger); ok {
+		leveled.Log(level, fmt.Sprintf(format, msg...), fields)
+
+		return
+	}
+
+	logger.Printf(format, msg...)
+}
+
 type debugLogger struct {
 	Logger
 	debug bool
 }
 
-// NewDebugLogger creates a wrapper around the argument logger to only log when debug flag is true
+// NewDebugLogger creates a wrapper around the argument logger to only log when debug flag is true,
+// except for the warnings and errors of the library, which are always logged.
+//
+// When the argument logger is a LeveledLogger, the wrapper logs the Printf messages at the debug level instead,
+// leaving the filtering to the level of the logger
 func NewDebugLogger(logger Logger, debug bool) Logger {
+	if leveled, ok := logger.(LeveledLogger); ok {
+		return leveledDebugLogger{leveled}
+	}
+
 	return debugLogger{
 		Logger: logger,
 		debug:  debug,
@@ -26,3 +222,17 @@ func (l debugLogger) Printf(format string, msg ...interface{}) {
 
 	l.Logger.Printf(format, msg...)
 }
+
+type leveledDebugLogger struct {
+	LeveledLogger
+}
+
+// Printf logs a formatted message at the debug level
+func (l leveledDebugLogger) Printf(format string, msg ...interface{}) {
+	l.LeveledLogger.Log(LevelDebug, fmt.Sprintf(format, msg...), nil)
+}
+
+// With returns a leveledDebugLogger adding the given fields to all its entries
+func (l leveledDebugLogger) With(fields Fields) LeveledLogger {
+	return leveledDebugLogger{l.LeveledLogger.With(fields)}
+}
@@ -1,9 +1,17 @@
 package dynamodbcopy_test
 
 import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/uniplaces/dynamodbcopy"
 	"github.com/uniplaces/dynamodbcopy/mocks"
 )
@@ -50,3 +58,145 @@ func TestDebugLogger(t *testing.T) {
 		)
 	}
 }
+
+func TestDebugLoggerWarnings(t *testing.T) {
+	t.Parallel()
+
+	srcDescription := buildDefaultTableDescription(srcTableName)
+	trgDescription := buildDefaultTableDescription(trgTableName)
+	trgDescription.ProvisionedThroughput.NumberOfDecreasesToday = aws.Int64(4)
+
+	provisioning := buildProvisioning(srcDescription, trgDescription)
+	provisioning.Target = &dynamodbcopy.Capacity{Read: 5, Write: 10}
+
+	srcService := &mocks.DynamoDBService{}
+	srcService.On("DescribeTable").Return(&srcDescription, nil).Once()
+
+	trgService := &mocks.DynamoDBService{}
+	trgService.On("DescribeTable").Return(&trgDescription, nil).Once()
+
+	buffer := &bytes.Buffer{}
+	provisioner := dynamodbcopy.NewProvisioner(
+		srcService,
+		trgService,
+		dynamodbcopy.DecreaseSkip,
+		dynamodbcopy.NewDebugLogger(log.New(buffer, "", 0), false),
+	)
+
+	_, err := provisioner.Update(provisioning)
+
+	require.Nil(t, err)
+	assert.Equal(
+		t,
+		"keeping target table trg-table-name capacity: it was already decreased 4 times today, "+
+			"so it might not be restored\n",
+		buffer.String(),
+	)
+
+	srcService.AssertExpectations(t)
+	trgService.AssertExpectations(t)
+}
+
+func TestLeveledLoggerText(t *testing.T) {
+	t.Parallel()
+
+	out := &bytes.Buffer{}
+	logger := dynamodbcopy.NewLeveledLogger(out, dynamodbcopy.LogFormatText, dynamodbcopy.LevelInfo)
+
+	logger.Log(dynamodbcopy.LevelDebug, "hidden", nil)
+	logger.Printf("copying %d items", 10)
+	logger.With(dynamodbcopy.Fields{"table": "src"}).
+		Log(dynamodbcopy.LevelWarn, "throttled", dynamodbcopy.Fields{"attempt": 2})
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 2)
+	assert.True(t, strings.HasSuffix(lines[0], " INFO copying 10 items"), lines[0])
+	assert.True(t, strings.HasSuffix(lines[1], " WARN throttled attempt=2 table=src"), lines[1])
+}
+
+func TestLeveledLoggerJSON(t *testing.T) {
+	t.Parallel()
+
+	out := &bytes.Buffer{}
+	logger := dynamodbcopy.NewLeveledLogger(out, dynamodbcopy.LogFormatJSON, dynamodbcopy.LevelDebug)
+
+	logger.With(dynamodbcopy.Fields{"table": "src"}).
+		Log(dynamodbcopy.LevelDebug, "page", dynamodbcopy.Fields{"segment": 1})
+
+	var entry map[string]interface{}
+	require.Nil(t, json.Unmarshal(out.Bytes(), &entry))
+
+	assert.Equal(t, "debug", entry["level"])
+	assert.Equal(t, "page", entry["msg"])
+	assert.Equal(t, "src", entry["table"])
+	assert.Equal(t, float64(1), entry["segment"])
+	assert.NotEmpty(t, entry["time"])
+}
+
+func TestDebugLoggerLeveled(t *testing.T) {
+	t.Parallel()
+
+	out := &bytes.Buffer{}
+	leveled := dynamodbcopy.NewLeveledLogger(out, dynamodbcopy.LogFormatText, dynamodbcopy.LevelInfo)
+
+	dynamodbcopy.NewDebugLogger(leveled, true).Printf("hidden")
+	assert.Empty(t, out.String())
+
+	leveled = dynamodbcopy.NewLeveledLogger(out, dynamodbcopy.LogFormatText, dynamodbcopy.LevelDebug)
+
+	dynamodbcopy.NewDebugLogger(leveled, false).Printf("shown")
+	assert.Contains(t, out.String(), " DEBUG shown")
+}
+
+func TestParseLevel(t *testing.T) {
+	t.Parallel()
+
+	level, err := dynamodbcopy.ParseLevel("warn")
+
+	assert.Nil(t, err)
+	assert.Equal(t, dynamodbcopy.LevelWarn, level)
+
+	_, err = dynamodbcopy.ParseLevel("invalid")
+
+	assert.NotNil(t, err)
+}
+
+func TestParseLogFormat(t *testing.T) {
+	t.Parallel()
+
+	format, err := dynamodbcopy.ParseLogFormat("json")
+
+	assert.Nil(t, err)
+	assert.Equal(t, dynamodbcopy.LogFormatJSON, format)
+
+	_, err = dynamodbcopy.ParseLogFormat("invalid")
+
+	assert.NotNil(t, err)
+}
+
+func TestLeveledLoggerFields(t *testing.T) {
+	t.Parallel()
+
+	fields := dynamodbcopy.Fields{"table": expectedTableName, "segment": 1}
+
+	logger := &mocks.LeveledLogger{}
+	logger.On("Log", dynamodbcopy.LevelDebug, mock.AnythingOfType("string"), fields).Twice()
+
+	api := &mocks.DynamoDBAPI{}
+	api.On("ScanPages", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			pager := args.Get(1).(func(*dynamodb.ScanOutput, bool) bool)
+			pager(&dynamodb.ScanOutput{Count: aws.Int64(1)}, true)
+		}).
+		Return(nil).
+		Once()
+
+	service := dynamodbcopy.NewDynamoDBService(expectedTableName, api, testSleeper, logger)
+
+	_, err := service.Count(dynamodbcopy.ScanOptions{}, 2, 1)
+
+	require.Nil(t, err)
+
+	logger.AssertExpectations(t)
+	api.AssertExpectations(t)
+}
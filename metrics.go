@@ -0,0 +1,242 @@
+package dynamodbcopy
+
+import (
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const metricsNamespace = "dynamodbcopy"
+
+// Metrics holds the Prometheus metrics of a copy. A nil *Metrics is valid and doesn't record anything
+type Metrics struct {
+	registry *prometheus.Registry
+
+	itemsScanned       *prometheus.CounterVec
+	itemsWritten       *prometheus.CounterVec
+	batchWriteDuration *prometheus.HistogramVec
+	unprocessedRetries *prometheus.CounterVec
+	throttles          *prometheus.CounterVec
+	consumedCapacity   *prometheus.CounterVec
+	provisionedUnits   *prometheus.GaugeVec
+}
+
+// NewMetrics returns a new Metrics, registered in its own Prometheus registry
+func NewMetrics() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		itemsScanned: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: metricsNamespace,
+				Name:      "items_scanned_total",
+				Help:      "Number of items read from a table by scans and queries.",
+			},
+			[]string{"table"},
+		),
+		itemsWritten: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: metricsNamespace,
+				Name:      "items_written_total",
+				Help:      "Number of items written into (or deleted from) a table.",
+			},
+			[]string{"table"},
+		),
+		batchWriteDuration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: metricsNamespace,
+				Name:      "batch_write_duration_seconds",
+				Help:      "Latency of the batch write requests (each attempt) to a table.",
+				Buckets:   prometheus.ExponentialBuckets(0.005, 2, 12),
+			},
+			[]string{"table"},
+		),
+		unprocessedRetries: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: metricsNamespace,
+				Name:      "unprocessed_items_total",
+				Help:      "Number of unprocessed items of the batch writes to a table, retried afterwards.",
+			},
+			[]string{"table"},
+		),
+		throttles: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: metricsNamespace,
+				Name:      "throttles_total",
+				Help:      "Number of requests to a table rejected by provisioned throughput or throttling errors.",
+			},
+			[]string{"table", "operation", "reason"},
+		),
+		consumedCapacity: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: metricsNamespace,
+				Name:      "consumed_capacity_units_total",
+				Help:      "Capacity units consumed on a table.",
+			},
+			[]string{"table", "capacity"},
+		),
+		provisionedUnits: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: metricsNamespace,
+				Name:      "provisioned_capacity_units",
+				Help:      "Current provisioned capacity units of the source and target tables.",
+			},
+			[]string{"table", "capacity"},
+		),
+	}
+
+	m.registry.MustRegister(
+		m.itemsScanned,
+		m.itemsWritten,
+		m.batchWriteDuration,
+		m.unprocessedRetries,
+		m.throttles,
+		m.consumedCapacity,
+		m.provisionedUnits,
+	)
+
+	return m
+}
+
+// RegisterBacklog exposes the number of scanned batches waiting in the given channel to be written
+func (m *Metrics) RegisterBacklog(items chan []DynamoDBItem) {
+	if m == nil {
+		return
+	}
+
+	backlog := prometheus.NewGaugeFunc(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "items_channel_backlog",
+			Help:      "Number of scanned batches of items waiting to be written.",
+		},
+		func() float64 {
+			return float64(len(items))
+		},
+	)
+	m.registry.MustRegister(backlog)
+}
+
+// Handler returns an http.Handler exposing the metrics in the Prometheus text format
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// Serve exposes the metrics on the /metrics path of an HTTP server listening on addr, returning once it's listening
+func (m *Metrics) Serve(addr string, logger Logger) (*http.Server, error) {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", m.Handler())
+	server := &http.Server{Handler: mux}
+
+	go func() {
+		if err := server.Serve(listener); err != nil && err != http.ErrServerClosed {
+			logWith(logger, LevelError, Fields{"addr": addr}, "metrics server error: %s", err)
+		}
+	}()
+
+	return server, nil
+}
+
+func (m *Metrics) scanned(table string, items int, capacityUnits float64) {
+	if m == nil {
+		return
+	}
+
+	m.itemsScanned.WithLabelValues(table).Add(float64(items))
+	m.consumedCapacity.WithLabelValues(table, "read").Add(capacityUnits)
+}
+
+func (m *Metrics) consumedRead(table string, capacityUnits float64) {
+	if m == nil {
+		return
+	}
+
+	m.consumedCapacity.WithLabelValues(table, "read").Add(capacityUnits)
+}
+
+func (m *Metrics) batchWritten(table string, duration time.Duration, written, unprocessed int, capacityUnits float64) {
+	if m == nil {
+		return
+	}
+
+	m.batchWriteDuration.WithLabelValues(table).Observe(duration.Seconds())
+	m.itemsWritten.WithLabelValues(table).Add(float64(written))
+	m.unprocessedRetries.WithLabelValues(table).Add(float64(unprocessed))
+	m.consumedCapacity.WithLabelValues(table, "write").Add(capacityUnits)
+}
+
+func (m *Metrics) written(table string, capacityUnits float64) {
+	if m == nil {
+		return
+	}
+
+	m.itemsWritten.WithLabelValues(table).Inc()
+	m.consumedCapacity.WithLabelValues(table, "write").Add(capacityUnits)
+}
+
+func (m *Metrics) throttled(table, operation, reason string) {
+	if m == nil {
+		return
+	}
+
+	m.throttles.WithLabelValues(table, operation, reason).Inc()
+}
+
+func (m *Metrics) provisioned(provisioning Provisioning) {
+	if m == nil {
+		return
+	}
+
+	for table, capacity := range map[string]*Capacity{"source": provisioning.Source, "target": provisioning.Target} {
+		if capacity == nil {
+			m.provisionedUnits.WithLabelValues(table, "read").Set(0)
+			m.provisionedUnits.WithLabelValues(table, "write").Set(0)
+
+			continue
+		}
+
+		m.provisionedUnits.WithLabelValues(table, "read").Set(float64(capacity.Read))
+		m.provisionedUnits.WithLabelValues(table, "write").Set(float64(capacity.Write))
+	}
+}
+
+type metricsProvisioner struct {
+	Provisioner
+	metrics *Metrics
+}
+
+// NewMetricsProvisioner returns a Provisioner that records the provisioned capacity of the tables in the given
+// Metrics after fetching and updating it
+func NewMetricsProvisioner(provisioner Provisioner, metrics *Metrics) Provisioner {
+	return metricsProvisioner{
+		Provisioner: provisioner,
+		metrics:     metrics,
+	}
+}
+
+// Fetch fetches the provisioning of the wrapped Provisioner, recording it
+func (p metricsProvisioner) Fetch() (Provisioning, error) {
+	provisioning, err := p.Provisioner.Fetch()
+	if err == nil {
+		p.metrics.provisioned(provisioning)
+	}
+
+	return provisioning, err
+}
+
+// Update updates the provisioning of the wrapped Provisioner, recording the updated provisioning
+func (p metricsProvisioner) Update(provisioning Provisioning) (Provisioning, error) {
+	updated, err := p.Provisioner.Update(provisioning)
+	if err == nil {
+		p.metrics.provisioned(updated)
+	}
+
+	return updated, err
+}
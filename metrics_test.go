@@ -0,0 +1,134 @@
+package dynamodbcopy_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uniplaces/dynamodbcopy"
+	"github.com/uniplaces/dynamodbcopy/mocks"
+)
+
+func scrapeMetrics(t *testing.T, metrics *dynamodbcopy.Metrics) string {
+	recorder := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	return recorder.Body.String()
+}
+
+func TestMetricsBatchWrite(t *testing.T) {
+	t.Parallel()
+
+	batchInput := buildBatchWriteItemInput(10)
+	throttleErr := awserr.New(dynamodb.ErrCodeProvisionedThroughputExceededException, "err", errors.New("throttled"))
+
+	api := &mocks.DynamoDBAPI{}
+	api.On("BatchWriteItem", &batchInput).Return(nil, throttleErr).Once()
+	api.On("BatchWriteItem", &batchInput).
+		Return(&dynamodb.BatchWriteItemOutput{UnprocessedItems: batchInput.RequestItems}, nil).
+		Once()
+	api.On("BatchWriteItem", &batchInput).Return(&dynamodb.BatchWriteItemOutput{}, nil).Once()
+
+	metrics := dynamodbcopy.NewMetrics()
+	service := dynamodbcopy.NewDynamoDBService(
+		expectedTableName,
+		api,
+		testSleeper,
+		log.New(ioutil.Discard, "", log.Ltime),
+		dynamodbcopy.WithMetrics(metrics),
+	)
+
+	require.Nil(t, service.BatchWrite(getItems(batchInput)))
+
+	body := scrapeMetrics(t, metrics)
+
+	assert.Contains(t, body, `dynamodbcopy_items_written_total{table="test-table-name"} 10`)
+	assert.Contains(t, body, `dynamodbcopy_unprocessed_items_total{table="test-table-name"} 10`)
+	assert.Contains(
+		t,
+		body,
+		`dynamodbcopy_throttles_total{operation="batch_write",reason="provisioned_throughput",table="test-table-name"} 1`,
+	)
+	assert.Contains(t, body, `dynamodbcopy_batch_write_duration_seconds_count{table="test-table-name"} 2`)
+
+	api.AssertExpectations(t)
+}
+
+func TestMetricsProvisioner(t *testing.T) {
+	t.Parallel()
+
+	provisioning := dynamodbcopy.Provisioning{Source: &dynamodbcopy.Capacity{Read: 10, Write: 5}}
+	updated := dynamodbcopy.Provisioning{
+		Source: &dynamodbcopy.Capacity{Read: 100, Write: 5},
+		Target: &dynamodbcopy.Capacity{Read: 5, Write: 50},
+	}
+
+	provisioner := &mocks.Provisioner{}
+	provisioner.On("Fetch").Return(provisioning, nil).Once()
+	provisioner.On("Update", updated).Return(updated, nil).Once()
+
+	metrics := dynamodbcopy.NewMetrics()
+	metricsProvisioner := dynamodbcopy.NewMetricsProvisioner(provisioner, metrics)
+
+	_, err := metricsProvisioner.Fetch()
+	require.Nil(t, err)
+
+	body := scrapeMetrics(t, metrics)
+	assert.Contains(t, body, `dynamodbcopy_provisioned_capacity_units{capacity="read",table="source"} 10`)
+	assert.Contains(t, body, `dynamodbcopy_provisioned_capacity_units{capacity="write",table="target"} 0`)
+
+	_, err = metricsProvisioner.Update(updated)
+	require.Nil(t, err)
+
+	body = scrapeMetrics(t, metrics)
+	assert.Contains(t, body, `dynamodbcopy_provisioned_capacity_units{capacity="read",table="source"} 100`)
+	assert.Contains(t, body, `dynamodbcopy_provisioned_capacity_units{capacity="write",table="target"} 50`)
+
+	provisioner.AssertExpectations(t)
+}
+
+func TestMetricsBacklog(t *testing.T) {
+	t.Parallel()
+
+	items := make(chan []dynamodbcopy.DynamoDBItem, 3)
+	items <- []dynamodbcopy.DynamoDBItem{}
+	items <- []dynamodbcopy.DynamoDBItem{}
+
+	metrics := dynamodbcopy.NewMetrics()
+	metrics.RegisterBacklog(items)
+
+	assert.Contains(t, scrapeMetrics(t, metrics), "dynamodbcopy_items_channel_backlog 2")
+}
+
+func TestNilMetrics(t *testing.T) {
+	t.Parallel()
+
+	batchInput := buildBatchWriteItemInput(10)
+
+	api := &mocks.DynamoDBAPI{}
+	api.On("BatchWriteItem", &batchInput).Return(&dynamodb.BatchWriteItemOutput{}, nil).Once()
+
+	service := dynamodbcopy.NewDynamoDBService(
+		expectedTableName,
+		api,
+		testSleeper,
+		log.New(ioutil.Discard, "", log.Ltime),
+		dynamodbcopy.WithMetrics(nil),
+	)
+
+	var metrics *dynamodbcopy.Metrics
+	metrics.RegisterBacklog(make(chan []dynamodbcopy.DynamoDBItem))
+
+	assert.Nil(t, service.BatchWrite(getItems(batchInput)))
+
+	api.AssertExpectations(t)
+}
@@ -0,0 +1,215 @@
+package dynamodbcopy
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+// KeySet stores the keys of the items of a table. It is safe for concurrent use
+type KeySet struct {
+	mutex *sync.Mutex
+	keys  map[string]struct{}
+}
+
+// NewKeySet creates a new empty KeySet
+func NewKeySet() *KeySet {
+	return &KeySet{
+		mutex: &sync.Mutex{},
+		keys:  make(map[string]struct{}),
+	}
+}
+
+// Add adds the key of the given item, made of the given key attributes, to the KeySet
+func (s *KeySet) Add(item DynamoDBItem, keyNames []string) {
+	key := formatKey(item, keyNames)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.keys[key] = struct{}{}
+}
+
+// Contains returns true if the key of the given item, made of the given key attributes, is in the KeySet
+func (s *KeySet) Contains(item DynamoDBItem, keyNames []string) bool {
+	key := formatKey(item, keyNames)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	_, ok := s.keys[key]
+
+	return ok
+}
+
+// Len returns the number of keys in the KeySet
+func (s *KeySet) Len() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return len(s.keys)
+}
+
+func formatKey(item DynamoDBItem, keyNames []string) string {
+	parts := make([]string, len(keyNames))
+	for i, name := range keyNames {
+		parts[i] = fmt.Sprintf("%s=%s", name, formatAttributeValue(item[name]))
+	}
+
+	return strings.Join(parts, "\x00")
+}
+
+// keyNames returns the sorted names of the key attributes of a table
+func keyNames(description *dynamodb.TableDescription) []string {
+	names := make([]string, len(description.KeySchema))
+	for i, key := range description.KeySchema {
+		names[i] = aws.StringValue(key.AttributeName)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+type keyRecordingService struct {
+	DynamoDBService
+	keys     *KeySet
+	keyNames []string
+}
+
+// NewKeyRecordingDynamoDBService wraps the given DynamoDBService so the keys of the items it scans are added to keys.
+// The key attribute names are described once, when the wrapper is built
+func NewKeyRecordingDynamoDBService(service DynamoDBService, keys *KeySet) (DynamoDBService, error) {
+	description, err := service.DescribeTable()
+	if err != nil {
+		return nil, err
+	}
+
+	return keyRecordingService{
+		DynamoDBService: service,
+		keys:            keys,
+		keyNames:        keyNames(description),
+	}, nil
+}
+
+// Scan performs a scan over the wrapped DynamoDBService, adding the key of each scanned item to the KeySet before
+// sending it into the provided itemsChan
+func (s keyRecordingService) Scan(totalSegments, segment int, itemsChan chan<- []DynamoDBItem) error {
+	recordedChan := make(chan []DynamoDBItem)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		for items := range recordedChan {
+			for _, item := range items {
+				s.keys.Add(item, s.keyNames)
+			}
+			itemsChan <- items
+		}
+	}()
+
+	err := s.DynamoDBService.Scan(totalSegments, segment, recordedChan)
+	close(recordedChan)
+	<-done
+
+	return err
+}
+
+// Mirror is the interface that allows you to delete the target items that don't exist in the source table
+type Mirror interface {
+	DeleteExtraneous(readers int) error
+}
+
+type mirrorService struct {
+	trgTable DynamoDBService
+	srcKeys  *KeySet
+	report   *WriteReport
+	logger   Logger
+}
+
+// NewMirror returns a new Mirror that deletes the items of the target table whose keys aren't in srcKeys,
+// which should be recorded while copying the source table (see NewKeyRecordingDynamoDBService).
+// The keys of all the source items are kept in memory
+func NewMirror(trgTableService DynamoDBService, srcKeys *KeySet, report *WriteReport, logger Logger) Mirror {
+	return mirrorService{
+		trgTable: trgTableService,
+		srcKeys:  srcKeys,
+		report:   report,
+		logger:   logger,
+	}
+}
+
+// DeleteExtraneous scans the target table with the given number of readers, deleting the items whose keys
+// aren't in the source KeySet with batch deletes
+func (service mirrorService) DeleteExtraneous(readers int) error {
+	description, err := service.trgTable.DescribeTable()
+	if err != nil {
+		return err
+	}
+	names := keyNames(description)
+
+	service.logger.Printf("deleting extraneous items with %d readers (%d source keys)", readers, service.srcKeys.Len())
+
+	itemsChan := make(chan []DynamoDBItem, readers)
+	errChan := make(chan error, readers+1)
+
+	wgReaders := &sync.WaitGroup{}
+	wgReaders.Add(readers)
+	for i := 0; i < readers; i++ {
+		go func(segment int) {
+			defer wgReaders.Done()
+
+			if err := service.trgTable.Scan(readers, segment, itemsChan); err != nil {
+				errChan <- err
+			}
+		}(i)
+	}
+
+	go func() {
+		wgReaders.Wait()
+		close(itemsChan)
+	}()
+
+	var deleteErr error
+	for items := range itemsChan {
+		if deleteErr != nil {
+			continue
+		}
+
+		var keys []DynamoDBItem
+		for _, item := range items {
+			if !service.srcKeys.Contains(item, names) {
+				keys = append(keys, itemKey(item, names))
+			}
+		}
+
+		if len(keys) == 0 {
+			continue
+		}
+
+		if deleteErr = service.trgTable.BatchDelete(keys); deleteErr == nil {
+			atomic.AddInt64(&service.report.deleted, int64(len(keys)))
+		}
+	}
+	close(errChan)
+
+	if deleteErr != nil {
+		return deleteErr
+	}
+
+	return <-errChan
+}
+
+func itemKey(item DynamoDBItem, keyNames []string) DynamoDBItem {
+	key := make(DynamoDBItem, len(keyNames))
+	for _, name := range keyNames {
+		key[name] = item[name]
+	}
+
+	return key
+}
@@ -0,0 +1,184 @@
+package dynamodbcopy_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/uniplaces/dynamodbcopy"
+	"github.com/uniplaces/dynamodbcopy/mocks"
+)
+
+func TestKeySet(t *testing.T) {
+	t.Parallel()
+
+	names := []string{"id", "sort"}
+	keys := dynamodbcopy.NewKeySet()
+
+	keys.Add(buildMirrorItem("1", "a"), names)
+	keys.Add(buildMirrorItem("1", "a"), names)
+	keys.Add(buildMirrorItem("1", "b"), names)
+
+	assert.Equal(t, 2, keys.Len())
+	assert.True(t, keys.Contains(buildMirrorItem("1", "b"), names))
+	assert.False(t, keys.Contains(buildMirrorItem("2", "a"), names))
+}
+
+func TestKeyRecordingScan(t *testing.T) {
+	t.Parallel()
+
+	description := buildKeyedTableDescription(srcTableName, "id", dynamodb.ScalarAttributeTypeS)
+	items := []dynamodbcopy.DynamoDBItem{buildMirrorItem("1", "a"), buildMirrorItem("2", "a")}
+
+	// the key attribute names are described once for all the scanned segments
+	service := &mocks.DynamoDBService{}
+	service.On("DescribeTable").Return(&description, nil).Once()
+	for segment, item := range items {
+		item := item
+		service.On("Scan", 2, segment, mock.Anything).
+			Run(func(args mock.Arguments) {
+				args.Get(2).(chan<- []dynamodbcopy.DynamoDBItem) <- []dynamodbcopy.DynamoDBItem{item}
+			}).
+			Return(nil).
+			Once()
+	}
+
+	keys := dynamodbcopy.NewKeySet()
+	itemsChan := make(chan []dynamodbcopy.DynamoDBItem, 2)
+
+	recordingService, err := dynamodbcopy.NewKeyRecordingDynamoDBService(service, keys)
+	require.Nil(t, err)
+
+	for segment := range items {
+		require.Nil(t, recordingService.Scan(2, segment, itemsChan))
+		assert.Equal(t, []dynamodbcopy.DynamoDBItem{items[segment]}, <-itemsChan)
+	}
+
+	assert.Equal(t, 2, keys.Len())
+	assert.True(t, keys.Contains(items[1], []string{"id"}))
+
+	service.AssertExpectations(t)
+}
+
+func TestKeyRecordingDescribeError(t *testing.T) {
+	t.Parallel()
+
+	service := &mocks.DynamoDBService{}
+	service.On("DescribeTable").Return(nil, errors.New("describe error")).Once()
+
+	_, err := dynamodbcopy.NewKeyRecordingDynamoDBService(service, dynamodbcopy.NewKeySet())
+
+	assert.NotNil(t, err)
+
+	service.AssertExpectations(t)
+}
+
+func TestDeleteExtraneous(t *testing.T) {
+	t.Parallel()
+
+	description := buildKeyedTableDescription(trgTableName, "id", dynamodb.ScalarAttributeTypeS)
+	items := []dynamodbcopy.DynamoDBItem{
+		buildMirrorItem("1", "a"),
+		buildMirrorItem("2", "a"),
+		buildMirrorItem("3", "a"),
+	}
+	extraneousKeys := []dynamodbcopy.DynamoDBItem{
+		{"id": items[1]["id"]},
+		{"id": items[2]["id"]},
+	}
+
+	expectedError := errors.New("mirror error")
+
+	testCases := []struct {
+		subTestName     string
+		mocker          func(service *mocks.DynamoDBService)
+		expectedDeleted int64
+		expectedError   bool
+	}{
+		{
+			"DescribeError",
+			func(service *mocks.DynamoDBService) {
+				service.On("DescribeTable").Return(nil, expectedError).Once()
+			},
+			0,
+			true,
+		},
+		{
+			"ScanError",
+			func(service *mocks.DynamoDBService) {
+				service.On("DescribeTable").Return(&description, nil).Once()
+				service.On("Scan", 1, 0, mock.Anything).Return(expectedError).Once()
+			},
+			0,
+			true,
+		},
+		{
+			"DeleteError",
+			func(service *mocks.DynamoDBService) {
+				service.On("DescribeTable").Return(&description, nil).Once()
+				service.On("Scan", 1, 0, mock.Anything).
+					Run(func(args mock.Arguments) {
+						args.Get(2).(chan<- []dynamodbcopy.DynamoDBItem) <- items
+					}).
+					Return(nil).
+					Once()
+				service.On("BatchDelete", extraneousKeys).Return(expectedError).Once()
+			},
+			0,
+			true,
+		},
+		{
+			"Success",
+			func(service *mocks.DynamoDBService) {
+				service.On("DescribeTable").Return(&description, nil).Once()
+				service.On("Scan", 1, 0, mock.Anything).
+					Run(func(args mock.Arguments) {
+						args.Get(2).(chan<- []dynamodbcopy.DynamoDBItem) <- items[:1]
+						args.Get(2).(chan<- []dynamodbcopy.DynamoDBItem) <- items[1:]
+					}).
+					Return(nil).
+					Once()
+				service.On("BatchDelete", extraneousKeys).Return(nil).Once()
+			},
+			2,
+			false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.subTestName,
+			func(st *testing.T) {
+				service := &mocks.DynamoDBService{}
+
+				testCase.mocker(service)
+
+				srcKeys := dynamodbcopy.NewKeySet()
+				srcKeys.Add(items[0], []string{"id"})
+
+				report := dynamodbcopy.NewWriteReport()
+				mirror := dynamodbcopy.NewMirror(service, srcKeys, report, log.New(ioutil.Discard, "", log.Ltime))
+
+				err := mirror.DeleteExtraneous(1)
+
+				assertExpectedError(st, testCase.expectedError, err)
+				assert.Equal(st, testCase.expectedDeleted, report.Deleted())
+
+				service.AssertExpectations(st)
+			},
+		)
+	}
+}
+
+func buildMirrorItem(id, sort string) dynamodbcopy.DynamoDBItem {
+	return dynamodbcopy.DynamoDBItem{
+		"id":   {S: aws.String(id)},
+		"sort": {S: aws.String(sort)},
+	}
+}
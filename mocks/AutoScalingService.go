@@ -0,0 +1,47 @@
+// Code generated by mockery v1.0.0
+package mocks
+
+import dynamodbcopy "github.com/uniplaces/dynamodbcopy"
+import mock "github.com/stretchr/testify/mock"
+
+// AutoScalingService is an autogenerated mock type for the AutoScalingService type
+type AutoScalingService struct {
+	mock.Mock
+}
+
+// DescribeScalableTargets provides a mock function with given fields: indexes
+func (_m *AutoScalingService) DescribeScalableTargets(indexes []string) ([]dynamodbcopy.ScalableTarget, error) {
+	ret := _m.Called(indexes)
+
+	var r0 []dynamodbcopy.ScalableTarget
+	if rf, ok := ret.Get(0).(func([]string) []dynamodbcopy.ScalableTarget); ok {
+		r0 = rf(indexes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dynamodbcopy.ScalableTarget)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(indexes)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RegisterScalableTarget provides a mock function with given fields: target
+func (_m *AutoScalingService) RegisterScalableTarget(target dynamodbcopy.ScalableTarget) error {
+	ret := _m.Called(target)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(dynamodbcopy.ScalableTarget) error); ok {
+		r0 = rf(target)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,54 @@
+// Code generated by mockery v1.0.0
+package mocks
+
+import dynamodbcopy "github.com/uniplaces/dynamodbcopy"
+import mock "github.com/stretchr/testify/mock"
+
+// Counter is an autogenerated mock type for the Counter type
+type Counter struct {
+	mock.Mock
+}
+
+// Count provides a mock function with given fields: readers
+func (_m *Counter) Count(readers int) (int64, error) {
+	ret := _m.Called(readers)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(int) int64); ok {
+		r0 = rf(readers)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(readers)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Profile provides a mock function with given fields: readers, writers
+func (_m *Counter) Profile(readers int, writers int) (*dynamodbcopy.Profile, error) {
+	ret := _m.Called(readers, writers)
+
+	var r0 *dynamodbcopy.Profile
+	if rf, ok := ret.Get(0).(func(int, int) *dynamodbcopy.Profile); ok {
+		r0 = rf(readers, writers)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodbcopy.Profile)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(readers, writers)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -12,6 +12,84 @@ type DynamoDBAPI struct {
 	mock.Mock
 }
 
+// BatchExecuteStatement provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) BatchExecuteStatement(_a0 *dynamodb.BatchExecuteStatementInput) (*dynamodb.BatchExecuteStatementOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.BatchExecuteStatementOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.BatchExecuteStatementInput) *dynamodb.BatchExecuteStatementOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.BatchExecuteStatementOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.BatchExecuteStatementInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BatchExecuteStatementRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) BatchExecuteStatementRequest(_a0 *dynamodb.BatchExecuteStatementInput) (*request.Request, *dynamodb.BatchExecuteStatementOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.BatchExecuteStatementInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.BatchExecuteStatementOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.BatchExecuteStatementInput) *dynamodb.BatchExecuteStatementOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.BatchExecuteStatementOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// BatchExecuteStatementWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) BatchExecuteStatementWithContext(_a0 aws.Context, _a1 *dynamodb.BatchExecuteStatementInput, _a2 ...request.Option) (*dynamodb.BatchExecuteStatementOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.BatchExecuteStatementOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.BatchExecuteStatementInput, ...request.Option) *dynamodb.BatchExecuteStatementOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.BatchExecuteStatementOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.BatchExecuteStatementInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // BatchGetItem provides a mock function with given fields: _a0
 func (_m *DynamoDBAPI) BatchGetItem(_a0 *dynamodb.BatchGetItemInput) (*dynamodb.BatchGetItemOutput, error) {
 	ret := _m.Called(_a0)
@@ -593,6 +671,84 @@ func (_m *DynamoDBAPI) DeleteItemWithContext(_a0 aws.Context, _a1 *dynamodb.Dele
 	return r0, r1
 }
 
+// DeleteResourcePolicy provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DeleteResourcePolicy(_a0 *dynamodb.DeleteResourcePolicyInput) (*dynamodb.DeleteResourcePolicyOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.DeleteResourcePolicyOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.DeleteResourcePolicyInput) *dynamodb.DeleteResourcePolicyOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.DeleteResourcePolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.DeleteResourcePolicyInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteResourcePolicyRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DeleteResourcePolicyRequest(_a0 *dynamodb.DeleteResourcePolicyInput) (*request.Request, *dynamodb.DeleteResourcePolicyOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.DeleteResourcePolicyInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.DeleteResourcePolicyOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.DeleteResourcePolicyInput) *dynamodb.DeleteResourcePolicyOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.DeleteResourcePolicyOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteResourcePolicyWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) DeleteResourcePolicyWithContext(_a0 aws.Context, _a1 *dynamodb.DeleteResourcePolicyInput, _a2 ...request.Option) (*dynamodb.DeleteResourcePolicyOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.DeleteResourcePolicyOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.DeleteResourcePolicyInput, ...request.Option) *dynamodb.DeleteResourcePolicyOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.DeleteResourcePolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.DeleteResourcePolicyInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteTable provides a mock function with given fields: _a0
 func (_m *DynamoDBAPI) DeleteTable(_a0 *dynamodb.DeleteTableInput) (*dynamodb.DeleteTableOutput, error) {
 	ret := _m.Called(_a0)
@@ -827,6 +983,84 @@ func (_m *DynamoDBAPI) DescribeContinuousBackupsWithContext(_a0 aws.Context, _a1
 	return r0, r1
 }
 
+// DescribeContributorInsights provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeContributorInsights(_a0 *dynamodb.DescribeContributorInsightsInput) (*dynamodb.DescribeContributorInsightsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.DescribeContributorInsightsOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeContributorInsightsInput) *dynamodb.DescribeContributorInsightsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.DescribeContributorInsightsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeContributorInsightsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeContributorInsightsRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeContributorInsightsRequest(_a0 *dynamodb.DescribeContributorInsightsInput) (*request.Request, *dynamodb.DescribeContributorInsightsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeContributorInsightsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.DescribeContributorInsightsOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeContributorInsightsInput) *dynamodb.DescribeContributorInsightsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.DescribeContributorInsightsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeContributorInsightsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) DescribeContributorInsightsWithContext(_a0 aws.Context, _a1 *dynamodb.DescribeContributorInsightsInput, _a2 ...request.Option) (*dynamodb.DescribeContributorInsightsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.DescribeContributorInsightsOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.DescribeContributorInsightsInput, ...request.Option) *dynamodb.DescribeContributorInsightsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.DescribeContributorInsightsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.DescribeContributorInsightsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DescribeEndpoints provides a mock function with given fields: _a0
 func (_m *DynamoDBAPI) DescribeEndpoints(_a0 *dynamodb.DescribeEndpointsInput) (*dynamodb.DescribeEndpointsOutput, error) {
 	ret := _m.Called(_a0)
@@ -905,6 +1139,84 @@ func (_m *DynamoDBAPI) DescribeEndpointsWithContext(_a0 aws.Context, _a1 *dynamo
 	return r0, r1
 }
 
+// DescribeExport provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeExport(_a0 *dynamodb.DescribeExportInput) (*dynamodb.DescribeExportOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.DescribeExportOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeExportInput) *dynamodb.DescribeExportOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.DescribeExportOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeExportInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeExportRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeExportRequest(_a0 *dynamodb.DescribeExportInput) (*request.Request, *dynamodb.DescribeExportOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeExportInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.DescribeExportOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeExportInput) *dynamodb.DescribeExportOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.DescribeExportOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeExportWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) DescribeExportWithContext(_a0 aws.Context, _a1 *dynamodb.DescribeExportInput, _a2 ...request.Option) (*dynamodb.DescribeExportOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.DescribeExportOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.DescribeExportInput, ...request.Option) *dynamodb.DescribeExportOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.DescribeExportOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.DescribeExportInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DescribeGlobalTable provides a mock function with given fields: _a0
 func (_m *DynamoDBAPI) DescribeGlobalTable(_a0 *dynamodb.DescribeGlobalTableInput) (*dynamodb.DescribeGlobalTableOutput, error) {
 	ret := _m.Called(_a0)
@@ -1061,21 +1373,21 @@ func (_m *DynamoDBAPI) DescribeGlobalTableWithContext(_a0 aws.Context, _a1 *dyna
 	return r0, r1
 }
 
-// DescribeLimits provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) DescribeLimits(_a0 *dynamodb.DescribeLimitsInput) (*dynamodb.DescribeLimitsOutput, error) {
+// DescribeImport provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeImport(_a0 *dynamodb.DescribeImportInput) (*dynamodb.DescribeImportOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *dynamodb.DescribeLimitsOutput
-	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeLimitsInput) *dynamodb.DescribeLimitsOutput); ok {
+	var r0 *dynamodb.DescribeImportOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeImportInput) *dynamodb.DescribeImportOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.DescribeLimitsOutput)
+			r0 = ret.Get(0).(*dynamodb.DescribeImportOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeLimitsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeImportInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1084,12 +1396,12 @@ func (_m *DynamoDBAPI) DescribeLimits(_a0 *dynamodb.DescribeLimitsInput) (*dynam
 	return r0, r1
 }
 
-// DescribeLimitsRequest provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) DescribeLimitsRequest(_a0 *dynamodb.DescribeLimitsInput) (*request.Request, *dynamodb.DescribeLimitsOutput) {
+// DescribeImportRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeImportRequest(_a0 *dynamodb.DescribeImportInput) (*request.Request, *dynamodb.DescribeImportOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeLimitsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeImportInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1097,20 +1409,20 @@ func (_m *DynamoDBAPI) DescribeLimitsRequest(_a0 *dynamodb.DescribeLimitsInput)
 		}
 	}
 
-	var r1 *dynamodb.DescribeLimitsOutput
-	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeLimitsInput) *dynamodb.DescribeLimitsOutput); ok {
+	var r1 *dynamodb.DescribeImportOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeImportInput) *dynamodb.DescribeImportOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*dynamodb.DescribeLimitsOutput)
+			r1 = ret.Get(1).(*dynamodb.DescribeImportOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeLimitsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *DynamoDBAPI) DescribeLimitsWithContext(_a0 aws.Context, _a1 *dynamodb.DescribeLimitsInput, _a2 ...request.Option) (*dynamodb.DescribeLimitsOutput, error) {
+// DescribeImportWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) DescribeImportWithContext(_a0 aws.Context, _a1 *dynamodb.DescribeImportInput, _a2 ...request.Option) (*dynamodb.DescribeImportOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1120,8 +1432,164 @@ func (_m *DynamoDBAPI) DescribeLimitsWithContext(_a0 aws.Context, _a1 *dynamodb.
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *dynamodb.DescribeLimitsOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.DescribeLimitsInput, ...request.Option) *dynamodb.DescribeLimitsOutput); ok {
+	var r0 *dynamodb.DescribeImportOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.DescribeImportInput, ...request.Option) *dynamodb.DescribeImportOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.DescribeImportOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.DescribeImportInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeKinesisStreamingDestination provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeKinesisStreamingDestination(_a0 *dynamodb.DescribeKinesisStreamingDestinationInput) (*dynamodb.DescribeKinesisStreamingDestinationOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.DescribeKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeKinesisStreamingDestinationInput) *dynamodb.DescribeKinesisStreamingDestinationOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.DescribeKinesisStreamingDestinationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeKinesisStreamingDestinationInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeKinesisStreamingDestinationRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeKinesisStreamingDestinationRequest(_a0 *dynamodb.DescribeKinesisStreamingDestinationInput) (*request.Request, *dynamodb.DescribeKinesisStreamingDestinationOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeKinesisStreamingDestinationInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.DescribeKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeKinesisStreamingDestinationInput) *dynamodb.DescribeKinesisStreamingDestinationOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.DescribeKinesisStreamingDestinationOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeKinesisStreamingDestinationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) DescribeKinesisStreamingDestinationWithContext(_a0 aws.Context, _a1 *dynamodb.DescribeKinesisStreamingDestinationInput, _a2 ...request.Option) (*dynamodb.DescribeKinesisStreamingDestinationOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.DescribeKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.DescribeKinesisStreamingDestinationInput, ...request.Option) *dynamodb.DescribeKinesisStreamingDestinationOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.DescribeKinesisStreamingDestinationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.DescribeKinesisStreamingDestinationInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeLimits provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeLimits(_a0 *dynamodb.DescribeLimitsInput) (*dynamodb.DescribeLimitsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.DescribeLimitsOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeLimitsInput) *dynamodb.DescribeLimitsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.DescribeLimitsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeLimitsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeLimitsRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeLimitsRequest(_a0 *dynamodb.DescribeLimitsInput) (*request.Request, *dynamodb.DescribeLimitsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeLimitsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.DescribeLimitsOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeLimitsInput) *dynamodb.DescribeLimitsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.DescribeLimitsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeLimitsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) DescribeLimitsWithContext(_a0 aws.Context, _a1 *dynamodb.DescribeLimitsInput, _a2 ...request.Option) (*dynamodb.DescribeLimitsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.DescribeLimitsOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.DescribeLimitsInput, ...request.Option) *dynamodb.DescribeLimitsOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
@@ -1162,6 +1630,84 @@ func (_m *DynamoDBAPI) DescribeTable(_a0 *dynamodb.DescribeTableInput) (*dynamod
 	return r0, r1
 }
 
+// DescribeTableReplicaAutoScaling provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeTableReplicaAutoScaling(_a0 *dynamodb.DescribeTableReplicaAutoScalingInput) (*dynamodb.DescribeTableReplicaAutoScalingOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.DescribeTableReplicaAutoScalingOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeTableReplicaAutoScalingInput) *dynamodb.DescribeTableReplicaAutoScalingOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.DescribeTableReplicaAutoScalingOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeTableReplicaAutoScalingInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeTableReplicaAutoScalingRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeTableReplicaAutoScalingRequest(_a0 *dynamodb.DescribeTableReplicaAutoScalingInput) (*request.Request, *dynamodb.DescribeTableReplicaAutoScalingOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeTableReplicaAutoScalingInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.DescribeTableReplicaAutoScalingOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeTableReplicaAutoScalingInput) *dynamodb.DescribeTableReplicaAutoScalingOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.DescribeTableReplicaAutoScalingOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeTableReplicaAutoScalingWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) DescribeTableReplicaAutoScalingWithContext(_a0 aws.Context, _a1 *dynamodb.DescribeTableReplicaAutoScalingInput, _a2 ...request.Option) (*dynamodb.DescribeTableReplicaAutoScalingOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.DescribeTableReplicaAutoScalingOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.DescribeTableReplicaAutoScalingInput, ...request.Option) *dynamodb.DescribeTableReplicaAutoScalingOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.DescribeTableReplicaAutoScalingOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.DescribeTableReplicaAutoScalingInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DescribeTableRequest provides a mock function with given fields: _a0
 func (_m *DynamoDBAPI) DescribeTableRequest(_a0 *dynamodb.DescribeTableInput) (*request.Request, *dynamodb.DescribeTableOutput) {
 	ret := _m.Called(_a0)
@@ -1295,21 +1841,21 @@ func (_m *DynamoDBAPI) DescribeTimeToLiveWithContext(_a0 aws.Context, _a1 *dynam
 	return r0, r1
 }
 
-// GetItem provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) GetItem(_a0 *dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error) {
+// DisableKinesisStreamingDestination provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DisableKinesisStreamingDestination(_a0 *dynamodb.DisableKinesisStreamingDestinationInput) (*dynamodb.DisableKinesisStreamingDestinationOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *dynamodb.GetItemOutput
-	if rf, ok := ret.Get(0).(func(*dynamodb.GetItemInput) *dynamodb.GetItemOutput); ok {
+	var r0 *dynamodb.DisableKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.DisableKinesisStreamingDestinationInput) *dynamodb.DisableKinesisStreamingDestinationOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.GetItemOutput)
+			r0 = ret.Get(0).(*dynamodb.DisableKinesisStreamingDestinationOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*dynamodb.GetItemInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*dynamodb.DisableKinesisStreamingDestinationInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1318,12 +1864,12 @@ func (_m *DynamoDBAPI) GetItem(_a0 *dynamodb.GetItemInput) (*dynamodb.GetItemOut
 	return r0, r1
 }
 
-// GetItemRequest provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) GetItemRequest(_a0 *dynamodb.GetItemInput) (*request.Request, *dynamodb.GetItemOutput) {
+// DisableKinesisStreamingDestinationRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DisableKinesisStreamingDestinationRequest(_a0 *dynamodb.DisableKinesisStreamingDestinationInput) (*request.Request, *dynamodb.DisableKinesisStreamingDestinationOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*dynamodb.GetItemInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*dynamodb.DisableKinesisStreamingDestinationInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1331,20 +1877,20 @@ func (_m *DynamoDBAPI) GetItemRequest(_a0 *dynamodb.GetItemInput) (*request.Requ
 		}
 	}
 
-	var r1 *dynamodb.GetItemOutput
-	if rf, ok := ret.Get(1).(func(*dynamodb.GetItemInput) *dynamodb.GetItemOutput); ok {
+	var r1 *dynamodb.DisableKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.DisableKinesisStreamingDestinationInput) *dynamodb.DisableKinesisStreamingDestinationOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*dynamodb.GetItemOutput)
+			r1 = ret.Get(1).(*dynamodb.DisableKinesisStreamingDestinationOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// GetItemWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *DynamoDBAPI) GetItemWithContext(_a0 aws.Context, _a1 *dynamodb.GetItemInput, _a2 ...request.Option) (*dynamodb.GetItemOutput, error) {
+// DisableKinesisStreamingDestinationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) DisableKinesisStreamingDestinationWithContext(_a0 aws.Context, _a1 *dynamodb.DisableKinesisStreamingDestinationInput, _a2 ...request.Option) (*dynamodb.DisableKinesisStreamingDestinationOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1354,17 +1900,17 @@ func (_m *DynamoDBAPI) GetItemWithContext(_a0 aws.Context, _a1 *dynamodb.GetItem
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *dynamodb.GetItemOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.GetItemInput, ...request.Option) *dynamodb.GetItemOutput); ok {
+	var r0 *dynamodb.DisableKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.DisableKinesisStreamingDestinationInput, ...request.Option) *dynamodb.DisableKinesisStreamingDestinationOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.GetItemOutput)
+			r0 = ret.Get(0).(*dynamodb.DisableKinesisStreamingDestinationOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.GetItemInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.DisableKinesisStreamingDestinationInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1373,21 +1919,21 @@ func (_m *DynamoDBAPI) GetItemWithContext(_a0 aws.Context, _a1 *dynamodb.GetItem
 	return r0, r1
 }
 
-// ListBackups provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) ListBackups(_a0 *dynamodb.ListBackupsInput) (*dynamodb.ListBackupsOutput, error) {
+// EnableKinesisStreamingDestination provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) EnableKinesisStreamingDestination(_a0 *dynamodb.EnableKinesisStreamingDestinationInput) (*dynamodb.EnableKinesisStreamingDestinationOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *dynamodb.ListBackupsOutput
-	if rf, ok := ret.Get(0).(func(*dynamodb.ListBackupsInput) *dynamodb.ListBackupsOutput); ok {
+	var r0 *dynamodb.EnableKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.EnableKinesisStreamingDestinationInput) *dynamodb.EnableKinesisStreamingDestinationOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.ListBackupsOutput)
+			r0 = ret.Get(0).(*dynamodb.EnableKinesisStreamingDestinationOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*dynamodb.ListBackupsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*dynamodb.EnableKinesisStreamingDestinationInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1396,12 +1942,12 @@ func (_m *DynamoDBAPI) ListBackups(_a0 *dynamodb.ListBackupsInput) (*dynamodb.Li
 	return r0, r1
 }
 
-// ListBackupsRequest provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) ListBackupsRequest(_a0 *dynamodb.ListBackupsInput) (*request.Request, *dynamodb.ListBackupsOutput) {
+// EnableKinesisStreamingDestinationRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) EnableKinesisStreamingDestinationRequest(_a0 *dynamodb.EnableKinesisStreamingDestinationInput) (*request.Request, *dynamodb.EnableKinesisStreamingDestinationOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*dynamodb.ListBackupsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*dynamodb.EnableKinesisStreamingDestinationInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1409,20 +1955,20 @@ func (_m *DynamoDBAPI) ListBackupsRequest(_a0 *dynamodb.ListBackupsInput) (*requ
 		}
 	}
 
-	var r1 *dynamodb.ListBackupsOutput
-	if rf, ok := ret.Get(1).(func(*dynamodb.ListBackupsInput) *dynamodb.ListBackupsOutput); ok {
+	var r1 *dynamodb.EnableKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.EnableKinesisStreamingDestinationInput) *dynamodb.EnableKinesisStreamingDestinationOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*dynamodb.ListBackupsOutput)
+			r1 = ret.Get(1).(*dynamodb.EnableKinesisStreamingDestinationOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ListBackupsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *DynamoDBAPI) ListBackupsWithContext(_a0 aws.Context, _a1 *dynamodb.ListBackupsInput, _a2 ...request.Option) (*dynamodb.ListBackupsOutput, error) {
+// EnableKinesisStreamingDestinationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) EnableKinesisStreamingDestinationWithContext(_a0 aws.Context, _a1 *dynamodb.EnableKinesisStreamingDestinationInput, _a2 ...request.Option) (*dynamodb.EnableKinesisStreamingDestinationOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1432,17 +1978,17 @@ func (_m *DynamoDBAPI) ListBackupsWithContext(_a0 aws.Context, _a1 *dynamodb.Lis
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *dynamodb.ListBackupsOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.ListBackupsInput, ...request.Option) *dynamodb.ListBackupsOutput); ok {
+	var r0 *dynamodb.EnableKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.EnableKinesisStreamingDestinationInput, ...request.Option) *dynamodb.EnableKinesisStreamingDestinationOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.ListBackupsOutput)
+			r0 = ret.Get(0).(*dynamodb.EnableKinesisStreamingDestinationOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.ListBackupsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.EnableKinesisStreamingDestinationInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1451,35 +1997,920 @@ func (_m *DynamoDBAPI) ListBackupsWithContext(_a0 aws.Context, _a1 *dynamodb.Lis
 	return r0, r1
 }
 
-// ListGlobalTables provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) ListGlobalTables(_a0 *dynamodb.ListGlobalTablesInput) (*dynamodb.ListGlobalTablesOutput, error) {
+// ExecuteStatement provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ExecuteStatement(_a0 *dynamodb.ExecuteStatementInput) (*dynamodb.ExecuteStatementOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *dynamodb.ListGlobalTablesOutput
-	if rf, ok := ret.Get(0).(func(*dynamodb.ListGlobalTablesInput) *dynamodb.ListGlobalTablesOutput); ok {
+	var r0 *dynamodb.ExecuteStatementOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.ExecuteStatementInput) *dynamodb.ExecuteStatementOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.ListGlobalTablesOutput)
+			r0 = ret.Get(0).(*dynamodb.ExecuteStatementOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*dynamodb.ListGlobalTablesInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*dynamodb.ExecuteStatementInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExecuteStatementRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ExecuteStatementRequest(_a0 *dynamodb.ExecuteStatementInput) (*request.Request, *dynamodb.ExecuteStatementOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.ExecuteStatementInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.ExecuteStatementOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.ExecuteStatementInput) *dynamodb.ExecuteStatementOutput); ok {
 		r1 = rf(_a0)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.ExecuteStatementOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ExecuteStatementWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) ExecuteStatementWithContext(_a0 aws.Context, _a1 *dynamodb.ExecuteStatementInput, _a2 ...request.Option) (*dynamodb.ExecuteStatementOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.ExecuteStatementOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.ExecuteStatementInput, ...request.Option) *dynamodb.ExecuteStatementOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ExecuteStatementOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.ExecuteStatementInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExecuteTransaction provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ExecuteTransaction(_a0 *dynamodb.ExecuteTransactionInput) (*dynamodb.ExecuteTransactionOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.ExecuteTransactionOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.ExecuteTransactionInput) *dynamodb.ExecuteTransactionOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ExecuteTransactionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.ExecuteTransactionInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExecuteTransactionRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ExecuteTransactionRequest(_a0 *dynamodb.ExecuteTransactionInput) (*request.Request, *dynamodb.ExecuteTransactionOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.ExecuteTransactionInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.ExecuteTransactionOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.ExecuteTransactionInput) *dynamodb.ExecuteTransactionOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.ExecuteTransactionOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ExecuteTransactionWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) ExecuteTransactionWithContext(_a0 aws.Context, _a1 *dynamodb.ExecuteTransactionInput, _a2 ...request.Option) (*dynamodb.ExecuteTransactionOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.ExecuteTransactionOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.ExecuteTransactionInput, ...request.Option) *dynamodb.ExecuteTransactionOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ExecuteTransactionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.ExecuteTransactionInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportTableToPointInTime provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ExportTableToPointInTime(_a0 *dynamodb.ExportTableToPointInTimeInput) (*dynamodb.ExportTableToPointInTimeOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.ExportTableToPointInTimeOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.ExportTableToPointInTimeInput) *dynamodb.ExportTableToPointInTimeOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ExportTableToPointInTimeOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.ExportTableToPointInTimeInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportTableToPointInTimeRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ExportTableToPointInTimeRequest(_a0 *dynamodb.ExportTableToPointInTimeInput) (*request.Request, *dynamodb.ExportTableToPointInTimeOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.ExportTableToPointInTimeInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.ExportTableToPointInTimeOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.ExportTableToPointInTimeInput) *dynamodb.ExportTableToPointInTimeOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.ExportTableToPointInTimeOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ExportTableToPointInTimeWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) ExportTableToPointInTimeWithContext(_a0 aws.Context, _a1 *dynamodb.ExportTableToPointInTimeInput, _a2 ...request.Option) (*dynamodb.ExportTableToPointInTimeOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.ExportTableToPointInTimeOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.ExportTableToPointInTimeInput, ...request.Option) *dynamodb.ExportTableToPointInTimeOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ExportTableToPointInTimeOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.ExportTableToPointInTimeInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetItem provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) GetItem(_a0 *dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.GetItemOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.GetItemInput) *dynamodb.GetItemOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.GetItemOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.GetItemInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetItemRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) GetItemRequest(_a0 *dynamodb.GetItemInput) (*request.Request, *dynamodb.GetItemOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.GetItemInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.GetItemOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.GetItemInput) *dynamodb.GetItemOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.GetItemOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetItemWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) GetItemWithContext(_a0 aws.Context, _a1 *dynamodb.GetItemInput, _a2 ...request.Option) (*dynamodb.GetItemOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.GetItemOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.GetItemInput, ...request.Option) *dynamodb.GetItemOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.GetItemOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.GetItemInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetResourcePolicy provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) GetResourcePolicy(_a0 *dynamodb.GetResourcePolicyInput) (*dynamodb.GetResourcePolicyOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.GetResourcePolicyOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.GetResourcePolicyInput) *dynamodb.GetResourcePolicyOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.GetResourcePolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.GetResourcePolicyInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetResourcePolicyRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) GetResourcePolicyRequest(_a0 *dynamodb.GetResourcePolicyInput) (*request.Request, *dynamodb.GetResourcePolicyOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.GetResourcePolicyInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.GetResourcePolicyOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.GetResourcePolicyInput) *dynamodb.GetResourcePolicyOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.GetResourcePolicyOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetResourcePolicyWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) GetResourcePolicyWithContext(_a0 aws.Context, _a1 *dynamodb.GetResourcePolicyInput, _a2 ...request.Option) (*dynamodb.GetResourcePolicyOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.GetResourcePolicyOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.GetResourcePolicyInput, ...request.Option) *dynamodb.GetResourcePolicyOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.GetResourcePolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.GetResourcePolicyInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportTable provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ImportTable(_a0 *dynamodb.ImportTableInput) (*dynamodb.ImportTableOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.ImportTableOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.ImportTableInput) *dynamodb.ImportTableOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ImportTableOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.ImportTableInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportTableRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ImportTableRequest(_a0 *dynamodb.ImportTableInput) (*request.Request, *dynamodb.ImportTableOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.ImportTableInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.ImportTableOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.ImportTableInput) *dynamodb.ImportTableOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.ImportTableOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ImportTableWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) ImportTableWithContext(_a0 aws.Context, _a1 *dynamodb.ImportTableInput, _a2 ...request.Option) (*dynamodb.ImportTableOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.ImportTableOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.ImportTableInput, ...request.Option) *dynamodb.ImportTableOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ImportTableOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.ImportTableInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListBackups provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ListBackups(_a0 *dynamodb.ListBackupsInput) (*dynamodb.ListBackupsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.ListBackupsOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListBackupsInput) *dynamodb.ListBackupsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ListBackupsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.ListBackupsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListBackupsRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ListBackupsRequest(_a0 *dynamodb.ListBackupsInput) (*request.Request, *dynamodb.ListBackupsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListBackupsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.ListBackupsOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.ListBackupsInput) *dynamodb.ListBackupsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.ListBackupsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListBackupsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) ListBackupsWithContext(_a0 aws.Context, _a1 *dynamodb.ListBackupsInput, _a2 ...request.Option) (*dynamodb.ListBackupsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.ListBackupsOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.ListBackupsInput, ...request.Option) *dynamodb.ListBackupsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ListBackupsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.ListBackupsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListContributorInsights provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ListContributorInsights(_a0 *dynamodb.ListContributorInsightsInput) (*dynamodb.ListContributorInsightsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.ListContributorInsightsOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListContributorInsightsInput) *dynamodb.ListContributorInsightsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ListContributorInsightsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.ListContributorInsightsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListContributorInsightsPages provides a mock function with given fields: _a0, _a1
+func (_m *DynamoDBAPI) ListContributorInsightsPages(_a0 *dynamodb.ListContributorInsightsInput, _a1 func(*dynamodb.ListContributorInsightsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListContributorInsightsInput, func(*dynamodb.ListContributorInsightsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListContributorInsightsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *DynamoDBAPI) ListContributorInsightsPagesWithContext(_a0 aws.Context, _a1 *dynamodb.ListContributorInsightsInput, _a2 func(*dynamodb.ListContributorInsightsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.ListContributorInsightsInput, func(*dynamodb.ListContributorInsightsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListContributorInsightsRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ListContributorInsightsRequest(_a0 *dynamodb.ListContributorInsightsInput) (*request.Request, *dynamodb.ListContributorInsightsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListContributorInsightsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.ListContributorInsightsOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.ListContributorInsightsInput) *dynamodb.ListContributorInsightsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.ListContributorInsightsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListContributorInsightsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) ListContributorInsightsWithContext(_a0 aws.Context, _a1 *dynamodb.ListContributorInsightsInput, _a2 ...request.Option) (*dynamodb.ListContributorInsightsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.ListContributorInsightsOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.ListContributorInsightsInput, ...request.Option) *dynamodb.ListContributorInsightsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ListContributorInsightsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.ListContributorInsightsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListExports provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ListExports(_a0 *dynamodb.ListExportsInput) (*dynamodb.ListExportsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.ListExportsOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListExportsInput) *dynamodb.ListExportsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ListExportsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.ListExportsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListExportsPages provides a mock function with given fields: _a0, _a1
+func (_m *DynamoDBAPI) ListExportsPages(_a0 *dynamodb.ListExportsInput, _a1 func(*dynamodb.ListExportsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListExportsInput, func(*dynamodb.ListExportsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListExportsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *DynamoDBAPI) ListExportsPagesWithContext(_a0 aws.Context, _a1 *dynamodb.ListExportsInput, _a2 func(*dynamodb.ListExportsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.ListExportsInput, func(*dynamodb.ListExportsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListExportsRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ListExportsRequest(_a0 *dynamodb.ListExportsInput) (*request.Request, *dynamodb.ListExportsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListExportsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.ListExportsOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.ListExportsInput) *dynamodb.ListExportsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.ListExportsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListExportsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) ListExportsWithContext(_a0 aws.Context, _a1 *dynamodb.ListExportsInput, _a2 ...request.Option) (*dynamodb.ListExportsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.ListExportsOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.ListExportsInput, ...request.Option) *dynamodb.ListExportsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ListExportsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.ListExportsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListGlobalTables provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ListGlobalTables(_a0 *dynamodb.ListGlobalTablesInput) (*dynamodb.ListGlobalTablesOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.ListGlobalTablesOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListGlobalTablesInput) *dynamodb.ListGlobalTablesOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ListGlobalTablesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.ListGlobalTablesInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListGlobalTablesRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ListGlobalTablesRequest(_a0 *dynamodb.ListGlobalTablesInput) (*request.Request, *dynamodb.ListGlobalTablesOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListGlobalTablesInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.ListGlobalTablesOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.ListGlobalTablesInput) *dynamodb.ListGlobalTablesOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.ListGlobalTablesOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListGlobalTablesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) ListGlobalTablesWithContext(_a0 aws.Context, _a1 *dynamodb.ListGlobalTablesInput, _a2 ...request.Option) (*dynamodb.ListGlobalTablesOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.ListGlobalTablesOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.ListGlobalTablesInput, ...request.Option) *dynamodb.ListGlobalTablesOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ListGlobalTablesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.ListGlobalTablesInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListImports provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ListImports(_a0 *dynamodb.ListImportsInput) (*dynamodb.ListImportsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.ListImportsOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListImportsInput) *dynamodb.ListImportsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ListImportsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.ListImportsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListImportsPages provides a mock function with given fields: _a0, _a1
+func (_m *DynamoDBAPI) ListImportsPages(_a0 *dynamodb.ListImportsInput, _a1 func(*dynamodb.ListImportsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListImportsInput, func(*dynamodb.ListImportsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListImportsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *DynamoDBAPI) ListImportsPagesWithContext(_a0 aws.Context, _a1 *dynamodb.ListImportsInput, _a2 func(*dynamodb.ListImportsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.ListImportsInput, func(*dynamodb.ListImportsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// ListGlobalTablesRequest provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) ListGlobalTablesRequest(_a0 *dynamodb.ListGlobalTablesInput) (*request.Request, *dynamodb.ListGlobalTablesOutput) {
+// ListImportsRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ListImportsRequest(_a0 *dynamodb.ListImportsInput) (*request.Request, *dynamodb.ListImportsOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*dynamodb.ListGlobalTablesInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListImportsInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1487,20 +2918,20 @@ func (_m *DynamoDBAPI) ListGlobalTablesRequest(_a0 *dynamodb.ListGlobalTablesInp
 		}
 	}
 
-	var r1 *dynamodb.ListGlobalTablesOutput
-	if rf, ok := ret.Get(1).(func(*dynamodb.ListGlobalTablesInput) *dynamodb.ListGlobalTablesOutput); ok {
+	var r1 *dynamodb.ListImportsOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.ListImportsInput) *dynamodb.ListImportsOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*dynamodb.ListGlobalTablesOutput)
+			r1 = ret.Get(1).(*dynamodb.ListImportsOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ListGlobalTablesWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *DynamoDBAPI) ListGlobalTablesWithContext(_a0 aws.Context, _a1 *dynamodb.ListGlobalTablesInput, _a2 ...request.Option) (*dynamodb.ListGlobalTablesOutput, error) {
+// ListImportsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) ListImportsWithContext(_a0 aws.Context, _a1 *dynamodb.ListImportsInput, _a2 ...request.Option) (*dynamodb.ListImportsOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1510,17 +2941,17 @@ func (_m *DynamoDBAPI) ListGlobalTablesWithContext(_a0 aws.Context, _a1 *dynamod
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *dynamodb.ListGlobalTablesOutput
-	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.ListGlobalTablesInput, ...request.Option) *dynamodb.ListGlobalTablesOutput); ok {
+	var r0 *dynamodb.ListImportsOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.ListImportsInput, ...request.Option) *dynamodb.ListImportsOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.ListGlobalTablesOutput)
+			r0 = ret.Get(0).(*dynamodb.ListImportsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.ListGlobalTablesInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.ListImportsInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1798,6 +3229,84 @@ func (_m *DynamoDBAPI) PutItemWithContext(_a0 aws.Context, _a1 *dynamodb.PutItem
 	return r0, r1
 }
 
+// PutResourcePolicy provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) PutResourcePolicy(_a0 *dynamodb.PutResourcePolicyInput) (*dynamodb.PutResourcePolicyOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.PutResourcePolicyOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.PutResourcePolicyInput) *dynamodb.PutResourcePolicyOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.PutResourcePolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.PutResourcePolicyInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutResourcePolicyRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) PutResourcePolicyRequest(_a0 *dynamodb.PutResourcePolicyInput) (*request.Request, *dynamodb.PutResourcePolicyOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.PutResourcePolicyInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.PutResourcePolicyOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.PutResourcePolicyInput) *dynamodb.PutResourcePolicyOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.PutResourcePolicyOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// PutResourcePolicyWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) PutResourcePolicyWithContext(_a0 aws.Context, _a1 *dynamodb.PutResourcePolicyInput, _a2 ...request.Option) (*dynamodb.PutResourcePolicyOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.PutResourcePolicyOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.PutResourcePolicyInput, ...request.Option) *dynamodb.PutResourcePolicyOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.PutResourcePolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.PutResourcePolicyInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Query provides a mock function with given fields: _a0
 func (_m *DynamoDBAPI) Query(_a0 *dynamodb.QueryInput) (*dynamodb.QueryOutput, error) {
 	ret := _m.Called(_a0)
@@ -2570,6 +4079,84 @@ func (_m *DynamoDBAPI) UpdateContinuousBackupsWithContext(_a0 aws.Context, _a1 *
 	return r0, r1
 }
 
+// UpdateContributorInsights provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) UpdateContributorInsights(_a0 *dynamodb.UpdateContributorInsightsInput) (*dynamodb.UpdateContributorInsightsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.UpdateContributorInsightsOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.UpdateContributorInsightsInput) *dynamodb.UpdateContributorInsightsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.UpdateContributorInsightsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.UpdateContributorInsightsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateContributorInsightsRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) UpdateContributorInsightsRequest(_a0 *dynamodb.UpdateContributorInsightsInput) (*request.Request, *dynamodb.UpdateContributorInsightsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.UpdateContributorInsightsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.UpdateContributorInsightsOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.UpdateContributorInsightsInput) *dynamodb.UpdateContributorInsightsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.UpdateContributorInsightsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// UpdateContributorInsightsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) UpdateContributorInsightsWithContext(_a0 aws.Context, _a1 *dynamodb.UpdateContributorInsightsInput, _a2 ...request.Option) (*dynamodb.UpdateContributorInsightsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.UpdateContributorInsightsOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.UpdateContributorInsightsInput, ...request.Option) *dynamodb.UpdateContributorInsightsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.UpdateContributorInsightsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.UpdateContributorInsightsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateGlobalTable provides a mock function with given fields: _a0
 func (_m *DynamoDBAPI) UpdateGlobalTable(_a0 *dynamodb.UpdateGlobalTableInput) (*dynamodb.UpdateGlobalTableOutput, error) {
 	ret := _m.Called(_a0)
@@ -2804,6 +4391,84 @@ func (_m *DynamoDBAPI) UpdateItemWithContext(_a0 aws.Context, _a1 *dynamodb.Upda
 	return r0, r1
 }
 
+// UpdateKinesisStreamingDestination provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) UpdateKinesisStreamingDestination(_a0 *dynamodb.UpdateKinesisStreamingDestinationInput) (*dynamodb.UpdateKinesisStreamingDestinationOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.UpdateKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.UpdateKinesisStreamingDestinationInput) *dynamodb.UpdateKinesisStreamingDestinationOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.UpdateKinesisStreamingDestinationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.UpdateKinesisStreamingDestinationInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateKinesisStreamingDestinationRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) UpdateKinesisStreamingDestinationRequest(_a0 *dynamodb.UpdateKinesisStreamingDestinationInput) (*request.Request, *dynamodb.UpdateKinesisStreamingDestinationOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.UpdateKinesisStreamingDestinationInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.UpdateKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.UpdateKinesisStreamingDestinationInput) *dynamodb.UpdateKinesisStreamingDestinationOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.UpdateKinesisStreamingDestinationOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// UpdateKinesisStreamingDestinationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) UpdateKinesisStreamingDestinationWithContext(_a0 aws.Context, _a1 *dynamodb.UpdateKinesisStreamingDestinationInput, _a2 ...request.Option) (*dynamodb.UpdateKinesisStreamingDestinationOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.UpdateKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.UpdateKinesisStreamingDestinationInput, ...request.Option) *dynamodb.UpdateKinesisStreamingDestinationOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.UpdateKinesisStreamingDestinationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.UpdateKinesisStreamingDestinationInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateTable provides a mock function with given fields: _a0
 func (_m *DynamoDBAPI) UpdateTable(_a0 *dynamodb.UpdateTableInput) (*dynamodb.UpdateTableOutput, error) {
 	ret := _m.Called(_a0)
@@ -2827,6 +4492,84 @@ func (_m *DynamoDBAPI) UpdateTable(_a0 *dynamodb.UpdateTableInput) (*dynamodb.Up
 	return r0, r1
 }
 
+// UpdateTableReplicaAutoScaling provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) UpdateTableReplicaAutoScaling(_a0 *dynamodb.UpdateTableReplicaAutoScalingInput) (*dynamodb.UpdateTableReplicaAutoScalingOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.UpdateTableReplicaAutoScalingOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.UpdateTableReplicaAutoScalingInput) *dynamodb.UpdateTableReplicaAutoScalingOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.UpdateTableReplicaAutoScalingOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.UpdateTableReplicaAutoScalingInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateTableReplicaAutoScalingRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) UpdateTableReplicaAutoScalingRequest(_a0 *dynamodb.UpdateTableReplicaAutoScalingInput) (*request.Request, *dynamodb.UpdateTableReplicaAutoScalingOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.UpdateTableReplicaAutoScalingInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.UpdateTableReplicaAutoScalingOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.UpdateTableReplicaAutoScalingInput) *dynamodb.UpdateTableReplicaAutoScalingOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.UpdateTableReplicaAutoScalingOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// UpdateTableReplicaAutoScalingWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) UpdateTableReplicaAutoScalingWithContext(_a0 aws.Context, _a1 *dynamodb.UpdateTableReplicaAutoScalingInput, _a2 ...request.Option) (*dynamodb.UpdateTableReplicaAutoScalingOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.UpdateTableReplicaAutoScalingOutput
+	if rf, ok := ret.Get(0).(func(aws.Context, *dynamodb.UpdateTableReplicaAutoScalingInput, ...request.Option) *dynamodb.UpdateTableReplicaAutoScalingOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.UpdateTableReplicaAutoScalingOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(aws.Context, *dynamodb.UpdateTableReplicaAutoScalingInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateTableRequest provides a mock function with given fields: _a0
 func (_m *DynamoDBAPI) UpdateTableRequest(_a0 *dynamodb.UpdateTableInput) (*request.Request, *dynamodb.UpdateTableOutput) {
 	ret := _m.Called(_a0)
@@ -10,6 +10,20 @@ type DynamoDBService struct {
 	mock.Mock
 }
 
+// BatchDelete provides a mock function with given fields: keys
+func (_m *DynamoDBService) BatchDelete(keys []dynamodbcopy.DynamoDBItem) error {
+	ret := _m.Called(keys)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]dynamodbcopy.DynamoDBItem) error); ok {
+		r0 = rf(keys)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // BatchWrite provides a mock function with given fields: items
 func (_m *DynamoDBService) BatchWrite(items []dynamodbcopy.DynamoDBItem) error {
 	ret := _m.Called(items)
@@ -24,6 +38,83 @@ func (_m *DynamoDBService) BatchWrite(items []dynamodbcopy.DynamoDBItem) error {
 	return r0
 }
 
+// ConditionalPut provides a mock function with given fields: item, condition
+func (_m *DynamoDBService) ConditionalPut(item dynamodbcopy.DynamoDBItem, condition dynamodbcopy.WriteCondition) (bool, error) {
+	ret := _m.Called(item, condition)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(dynamodbcopy.DynamoDBItem, dynamodbcopy.WriteCondition) bool); ok {
+		r0 = rf(item, condition)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(dynamodbcopy.DynamoDBItem, dynamodbcopy.WriteCondition) error); ok {
+		r1 = rf(item, condition)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConsumedCapacity provides a mock function with given fields:
+func (_m *DynamoDBService) ConsumedCapacity() dynamodbcopy.ConsumedCapacity {
+	ret := _m.Called()
+
+	var r0 dynamodbcopy.ConsumedCapacity
+	if rf, ok := ret.Get(0).(func() dynamodbcopy.ConsumedCapacity); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(dynamodbcopy.ConsumedCapacity)
+	}
+
+	return r0
+}
+
+// ContributorInsights provides a mock function with given fields:
+func (_m *DynamoDBService) ContributorInsights() (bool, error) {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Count provides a mock function with given fields: options, totalSegments, segment
+func (_m *DynamoDBService) Count(options dynamodbcopy.ScanOptions, totalSegments int, segment int) (int64, error) {
+	ret := _m.Called(options, totalSegments, segment)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(dynamodbcopy.ScanOptions, int, int) int64); ok {
+		r0 = rf(options, totalSegments, segment)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(dynamodbcopy.ScanOptions, int, int) error); ok {
+		r1 = rf(options, totalSegments, segment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DescribeTable provides a mock function with given fields:
 func (_m *DynamoDBService) DescribeTable() (*dynamodb.TableDescription, error) {
 	ret := _m.Called()
@@ -47,6 +138,62 @@ func (_m *DynamoDBService) DescribeTable() (*dynamodb.TableDescription, error) {
 	return r0, r1
 }
 
+// IsEmpty provides a mock function with given fields:
+func (_m *DynamoDBService) IsEmpty() (bool, error) {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PointInTimeRecovery provides a mock function with given fields:
+func (_m *DynamoDBService) PointInTimeRecovery() (bool, error) {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Query provides a mock function with given fields: keyCondition, options, itemsChan
+func (_m *DynamoDBService) Query(keyCondition string, options dynamodbcopy.ScanOptions, itemsChan chan<- []dynamodbcopy.DynamoDBItem) error {
+	ret := _m.Called(keyCondition, options, itemsChan)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, dynamodbcopy.ScanOptions, chan<- []dynamodbcopy.DynamoDBItem) error); ok {
+		r0 = rf(keyCondition, options, itemsChan)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Scan provides a mock function with given fields: totalSegments, segment, itemsChan
 func (_m *DynamoDBService) Scan(totalSegments int, segment int, itemsChan chan<- []dynamodbcopy.DynamoDBItem) error {
 	ret := _m.Called(totalSegments, segment, itemsChan)
@@ -61,6 +208,94 @@ func (_m *DynamoDBService) Scan(totalSegments int, segment int, itemsChan chan<-
 	return r0
 }
 
+// ScanWithOptions provides a mock function with given fields: options, totalSegments, segment, itemsChan
+func (_m *DynamoDBService) ScanWithOptions(options dynamodbcopy.ScanOptions, totalSegments int, segment int, itemsChan chan<- []dynamodbcopy.DynamoDBItem) error {
+	ret := _m.Called(options, totalSegments, segment, itemsChan)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(dynamodbcopy.ScanOptions, int, int, chan<- []dynamodbcopy.DynamoDBItem) error); ok {
+		r0 = rf(options, totalSegments, segment, itemsChan)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TagTable provides a mock function with given fields: tags
+func (_m *DynamoDBService) TagTable(tags map[string]string) error {
+	ret := _m.Called(tags)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(map[string]string) error); ok {
+		r0 = rf(tags)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Tags provides a mock function with given fields:
+func (_m *DynamoDBService) Tags() (map[string]string, error) {
+	ret := _m.Called()
+
+	var r0 map[string]string
+	if rf, ok := ret.Get(0).(func() map[string]string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TimeToLive provides a mock function with given fields:
+func (_m *DynamoDBService) TimeToLive() (*dynamodb.TimeToLiveDescription, error) {
+	ret := _m.Called()
+
+	var r0 *dynamodb.TimeToLiveDescription
+	if rf, ok := ret.Get(0).(func() *dynamodb.TimeToLiveDescription); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.TimeToLiveDescription)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateBillingMode provides a mock function with given fields: capacity
+func (_m *DynamoDBService) UpdateBillingMode(capacity *dynamodbcopy.Capacity) error {
+	ret := _m.Called(capacity)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*dynamodbcopy.Capacity) error); ok {
+		r0 = rf(capacity)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateCapacity provides a mock function with given fields: capacity
 func (_m *DynamoDBService) UpdateCapacity(capacity dynamodbcopy.Capacity) error {
 	ret := _m.Called(capacity)
@@ -75,6 +310,90 @@ func (_m *DynamoDBService) UpdateCapacity(capacity dynamodbcopy.Capacity) error
 	return r0
 }
 
+// UpdateContributorInsights provides a mock function with given fields: enabled
+func (_m *DynamoDBService) UpdateContributorInsights(enabled bool) error {
+	ret := _m.Called(enabled)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(bool) error); ok {
+		r0 = rf(enabled)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateDeletionProtection provides a mock function with given fields: enabled
+func (_m *DynamoDBService) UpdateDeletionProtection(enabled bool) error {
+	ret := _m.Called(enabled)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(bool) error); ok {
+		r0 = rf(enabled)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdatePointInTimeRecovery provides a mock function with given fields: enabled
+func (_m *DynamoDBService) UpdatePointInTimeRecovery(enabled bool) error {
+	ret := _m.Called(enabled)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(bool) error); ok {
+		r0 = rf(enabled)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateProvisioning provides a mock function with given fields: capacity, indexes
+func (_m *DynamoDBService) UpdateProvisioning(capacity *dynamodbcopy.Capacity, indexes map[string]*dynamodbcopy.Capacity) error {
+	ret := _m.Called(capacity, indexes)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*dynamodbcopy.Capacity, map[string]*dynamodbcopy.Capacity) error); ok {
+		r0 = rf(capacity, indexes)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateStream provides a mock function with given fields: stream
+func (_m *DynamoDBService) UpdateStream(stream *dynamodb.StreamSpecification) error {
+	ret := _m.Called(stream)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*dynamodb.StreamSpecification) error); ok {
+		r0 = rf(stream)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateTimeToLive provides a mock function with given fields: attributeName, enabled
+func (_m *DynamoDBService) UpdateTimeToLive(attributeName string, enabled bool) error {
+	ret := _m.Called(attributeName, enabled)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, bool) error); ok {
+		r0 = rf(attributeName, enabled)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // WaitForReadyTable provides a mock function with given fields:
 func (_m *DynamoDBService) WaitForReadyTable() error {
 	ret := _m.Called()
@@ -0,0 +1,30 @@
+// Code generated by mockery v1.0.0
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// ItemDeleter is an autogenerated mock type for the ItemDeleter type
+type ItemDeleter struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: readers, writers
+func (_m *ItemDeleter) Delete(readers int, writers int) (int64, error) {
+	ret := _m.Called(readers, writers)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(int, int) int64); ok {
+		r0 = rf(readers, writers)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(readers, writers)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
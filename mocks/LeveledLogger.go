@@ -0,0 +1,39 @@
+// Code generated by mockery v1.0.0
+package mocks
+
+import dynamodbcopy "github.com/uniplaces/dynamodbcopy"
+import mock "github.com/stretchr/testify/mock"
+
+// LeveledLogger is an autogenerated mock type for the LeveledLogger type
+type LeveledLogger struct {
+	mock.Mock
+}
+
+// Log provides a mock function with given fields: level, msg, fields
+func (_m *LeveledLogger) Log(level dynamodbcopy.Level, msg string, fields dynamodbcopy.Fields) {
+	_m.Called(level, msg, fields)
+}
+
+// Printf provides a mock function with given fields: format, msg
+func (_m *LeveledLogger) Printf(format string, msg ...interface{}) {
+	var _ca []interface{}
+	_ca = append(_ca, format)
+	_ca = append(_ca, msg...)
+	_m.Called(_ca...)
+}
+
+// With provides a mock function with given fields: fields
+func (_m *LeveledLogger) With(fields dynamodbcopy.Fields) dynamodbcopy.LeveledLogger {
+	ret := _m.Called(fields)
+
+	var r0 dynamodbcopy.LeveledLogger
+	if rf, ok := ret.Get(0).(func(dynamodbcopy.Fields) dynamodbcopy.LeveledLogger); ok {
+		r0 = rf(fields)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dynamodbcopy.LeveledLogger)
+		}
+	}
+
+	return r0
+}
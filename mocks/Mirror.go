@@ -0,0 +1,23 @@
+// Code generated by mockery v1.0.0
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// Mirror is an autogenerated mock type for the Mirror type
+type Mirror struct {
+	mock.Mock
+}
+
+// DeleteExtraneous provides a mock function with given fields: readers
+func (_m *Mirror) DeleteExtraneous(readers int) error {
+	ret := _m.Called(readers)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(readers)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,31 @@
+// Code generated by mockery v1.0.0
+package mocks
+
+import dynamodbcopy "github.com/uniplaces/dynamodbcopy"
+import mock "github.com/stretchr/testify/mock"
+
+// Planner is an autogenerated mock type for the Planner type
+type Planner struct {
+	mock.Mock
+}
+
+// Plan provides a mock function with given fields: current
+func (_m *Planner) Plan(current dynamodbcopy.Provisioning) (dynamodbcopy.Plan, error) {
+	ret := _m.Called(current)
+
+	var r0 dynamodbcopy.Plan
+	if rf, ok := ret.Get(0).(func(dynamodbcopy.Provisioning) dynamodbcopy.Plan); ok {
+		r0 = rf(current)
+	} else {
+		r0 = ret.Get(0).(dynamodbcopy.Plan)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(dynamodbcopy.Provisioning) error); ok {
+		r1 = rf(current)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
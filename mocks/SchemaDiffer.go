@@ -0,0 +1,33 @@
+// Code generated by mockery v1.0.0
+package mocks
+
+import dynamodbcopy "github.com/uniplaces/dynamodbcopy"
+import mock "github.com/stretchr/testify/mock"
+
+// SchemaDiffer is an autogenerated mock type for the SchemaDiffer type
+type SchemaDiffer struct {
+	mock.Mock
+}
+
+// Diff provides a mock function with given fields:
+func (_m *SchemaDiffer) Diff() (dynamodbcopy.SchemaDiff, error) {
+	ret := _m.Called()
+
+	var r0 dynamodbcopy.SchemaDiff
+	if rf, ok := ret.Get(0).(func() dynamodbcopy.SchemaDiff); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dynamodbcopy.SchemaDiff)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
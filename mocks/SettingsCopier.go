@@ -0,0 +1,23 @@
+// Code generated by mockery v1.0.0
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// SettingsCopier is an autogenerated mock type for the SettingsCopier type
+type SettingsCopier struct {
+	mock.Mock
+}
+
+// CopySettings provides a mock function with given fields:
+func (_m *SettingsCopier) CopySettings() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
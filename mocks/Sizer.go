@@ -0,0 +1,31 @@
+// Code generated by mockery v1.0.0
+package mocks
+
+import dynamodbcopy "github.com/uniplaces/dynamodbcopy"
+import mock "github.com/stretchr/testify/mock"
+
+// Sizer is an autogenerated mock type for the Sizer type
+type Sizer struct {
+	mock.Mock
+}
+
+// Size provides a mock function with given fields: config
+func (_m *Sizer) Size(config dynamodbcopy.Config) (dynamodbcopy.Sizing, error) {
+	ret := _m.Called(config)
+
+	var r0 dynamodbcopy.Sizing
+	if rf, ok := ret.Get(0).(func(dynamodbcopy.Config) dynamodbcopy.Sizing); ok {
+		r0 = rf(config)
+	} else {
+		r0 = ret.Get(0).(dynamodbcopy.Sizing)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(dynamodbcopy.Config) error); ok {
+		r1 = rf(config)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
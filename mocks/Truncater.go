@@ -0,0 +1,23 @@
+// Code generated by mockery v1.0.0
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// Truncater is an autogenerated mock type for the Truncater type
+type Truncater struct {
+	mock.Mock
+}
+
+// Truncate provides a mock function with given fields: readers, writers
+func (_m *Truncater) Truncate(readers int, writers int) error {
+	ret := _m.Called(readers, writers)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, int) error); ok {
+		r0 = rf(readers, writers)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
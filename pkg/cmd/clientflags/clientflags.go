@@ -0,0 +1,79 @@
+package clientflags
+
+import (
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"github.com/uniplaces/dynamodbcopy"
+)
+
+const (
+	retryAttemptsKey = "retry-max-attempts"
+	retryElapsedKey  = "retry-max-elapsed"
+	retryBaseKey     = "retry-base-delay"
+	retryMaxKey      = "retry-max-delay"
+	retryJitterKey   = "retry-jitter"
+	tableWaitKey     = "table-wait-max-elapsed"
+	sessionKey       = "session-duration"
+)
+
+// BindFlags binds the flags shared by the commands to retry the DynamoDB requests and refresh the assumed roles
+func BindFlags(flagSet *pflag.FlagSet) {
+	flagSet.Int(retryAttemptsKey, 0, "max attempts of a throttled or failed request (0 for no limit)")
+	flagSet.Duration(
+		retryElapsedKey,
+		dynamodbcopy.DefaultRetryMaxElapsed,
+		"max time spent retrying a throttled or failed request (0 for no limit)",
+	)
+	flagSet.Duration(retryBaseKey, dynamodbcopy.DefaultRetryBaseDelay, "delay before the first retry")
+	flagSet.Duration(retryMaxKey, dynamodbcopy.DefaultRetryMaxDelay, "max delay between retries")
+	flagSet.String(
+		retryJitterKey,
+		string(dynamodbcopy.JitterFull),
+		"jitter of the exponential retry delays: full (random up to the exponential delay) "+
+			"or decorrelated (random between the base delay and three times the previous delay)",
+	)
+	flagSet.Duration(
+		tableWaitKey,
+		dynamodbcopy.DefaultTableWaitMaxElapsed,
+		"max time spent waiting for a table (and its indexes) to be ready after an update (0 for no limit)",
+	)
+	flagSet.Duration(
+		sessionKey,
+		dynamodbcopy.DefaultSessionDuration,
+		"duration of the sessions of the assumed roles, whose credentials are refreshed before they expire",
+	)
+}
+
+// ServiceOptions returns the DynamoDBService options of the bound retry flags: the retry policy of the requests
+// and the policy of the waits for a table to be ready, retrying with the same delays for their own max elapsed time
+func ServiceOptions(config *viper.Viper) ([]dynamodbcopy.DynamoDBServiceOption, error) {
+	jitter, err := dynamodbcopy.ParseJitter(config.GetString(retryJitterKey))
+	if err != nil {
+		return nil, err
+	}
+
+	retryPolicy := dynamodbcopy.NewRetryPolicy(
+		config.GetInt(retryAttemptsKey),
+		config.GetDuration(retryElapsedKey),
+		config.GetDuration(retryBaseKey),
+		config.GetDuration(retryMaxKey),
+		jitter,
+	)
+	waitPolicy := dynamodbcopy.NewRetryPolicy(
+		0,
+		config.GetDuration(tableWaitKey),
+		config.GetDuration(retryBaseKey),
+		config.GetDuration(retryMaxKey),
+		jitter,
+	)
+
+	return []dynamodbcopy.DynamoDBServiceOption{
+		dynamodbcopy.WithRetryPolicy(retryPolicy),
+		dynamodbcopy.WithTableWaitPolicy(waitPolicy),
+	}, nil
+}
+
+// NewDynamoClient creates a DynamoDB client assuming roleArn (if any) for sessions of the bound session duration
+func NewDynamoClient(config *viper.Viper, roleArn string, logger dynamodbcopy.Logger) dynamodbcopy.DynamoDBClient {
+	return dynamodbcopy.NewRefreshingDynamoClient(roleArn, config.GetDuration(sessionKey), logger)
+}
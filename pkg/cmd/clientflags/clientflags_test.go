@@ -0,0 +1,50 @@
+package clientflags
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBindFlags(t *testing.T) {
+	t.Parallel()
+
+	cmd := &cobra.Command{}
+
+	BindFlags(cmd.Flags())
+
+	require.NotNil(t, cmd.Flag("retry-max-attempts"))
+	require.NotNil(t, cmd.Flag("retry-max-elapsed"))
+	require.NotNil(t, cmd.Flag("retry-base-delay"))
+	require.NotNil(t, cmd.Flag("retry-max-delay"))
+	require.NotNil(t, cmd.Flag("retry-jitter"))
+	require.NotNil(t, cmd.Flag("table-wait-max-elapsed"))
+	require.NotNil(t, cmd.Flag("session-duration"))
+}
+
+func TestServiceOptions(t *testing.T) {
+	t.Parallel()
+
+	cmd := &cobra.Command{}
+	BindFlags(cmd.Flags())
+
+	config := viper.New()
+	require.Nil(t, config.BindPFlags(cmd.Flags()))
+
+	options, err := ServiceOptions(config)
+
+	require.Nil(t, err)
+	assert.Len(t, options, 2)
+	assert.NotNil(t, NewDynamoClient(config, "", log.New(os.Stdout, "", log.LstdFlags)))
+
+	require.Nil(t, cmd.Flags().Set("retry-jitter", "invalid"))
+
+	_, err = ServiceOptions(config)
+
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,61 @@
+package copysettings
+
+import (
+	"errors"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+	"github.com/uniplaces/dynamodbcopy/mocks"
+)
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	for _, settingsErr := range []error{nil, errors.New("copy settings error")} {
+		settingsMock := &mocks.SettingsCopier{}
+		settingsMock.On("CopySettings").Return(settingsErr).Once()
+
+		err := run(dependencies{Settings: settingsMock})
+
+		if settingsErr != nil {
+			require.NotNil(t, err)
+		} else {
+			require.Nil(t, err)
+		}
+
+		settingsMock.AssertExpectations(t)
+	}
+}
+
+func TestBindFlags(t *testing.T) {
+	t.Parallel()
+
+	cmd := &cobra.Command{}
+
+	bindFlags(cmd.Flags())
+
+	require.NotNil(t, cmd.Flag("source-role-arn"))
+	require.NotNil(t, cmd.Flag("target-role-arn"))
+	require.NotNil(t, cmd.Flag("retry-max-attempts"))
+	require.NotNil(t, cmd.Flag("retry-max-elapsed"))
+	require.NotNil(t, cmd.Flag("retry-base-delay"))
+	require.NotNil(t, cmd.Flag("retry-max-delay"))
+	require.NotNil(t, cmd.Flag("retry-jitter"))
+	require.NotNil(t, cmd.Flag("table-wait-max-elapsed"))
+	require.NotNil(t, cmd.Flag("session-duration"))
+	require.NotNil(t, cmd.Flag("debug"))
+}
+
+func TestSetupDependencies(t *testing.T) {
+	cmd := &cobra.Command{}
+
+	bindFlags(cmd.Flags())
+
+	deps, err := setupDependencies(cmd, []string{"src", "trg"}, log.New(os.Stdout, "", log.LstdFlags))
+
+	require.Nil(t, err)
+	require.NotNil(t, deps.Settings)
+}
@@ -2,11 +2,14 @@ package copytable
 
 import (
 	"fmt"
+	"os"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 	"github.com/uniplaces/dynamodbcopy"
+	"github.com/uniplaces/dynamodbcopy/pkg/cmd/clientflags"
 )
 
 const (
@@ -23,6 +26,27 @@ const (
 	writeCapacityKey = "write-capacity"
 	readerCountKey   = "reader-count"
 	writerCountKey   = "writer-count"
+	segmentsKey      = "segments"
+	autoKey          = "auto"
+	maxBufferKey     = "max-buffer-bytes"
+	onDemandKey      = "on-demand"
+	autoScalingKey   = "auto-scaling"
+	decreaseKey      = "decrease-policy"
+	rampStepKey      = "ramp-step"
+	rampIntervalKey  = "ramp-interval"
+	rampDownKey      = "ramp-down-steps"
+	dryRunKey        = "dry-run"
+	forceKey         = "force"
+	protectedKey     = "protected-tables"
+	requiredTagsKey  = "required-target-tags"
+	yesKey           = "yes"
+	onConflictKey    = "on-conflict"
+	newerKey         = "newer-attribute"
+	deleteKey        = "delete-extraneous"
+	settingsKey      = "copy-settings"
+	metricsAddrKey   = "metrics-addr"
+	traceExporterKey = "trace-exporter"
+	traceTargetKey   = "trace-target"
 	debugKey         = "debug"
 )
 
@@ -47,6 +71,98 @@ func bindFlags(flagSet *pflag.FlagSet) {
 	flagSet.Int(writeCapacityKey, 0, "write provisioning capacity to set on the target table")
 	flagSet.IntP(readerCountKey, "r", 1, "number of read workers to use")
 	flagSet.IntP(writerCountKey, "w", 1, "number of write workers to use")
+	flagSet.Int(
+		segmentsKey,
+		0,
+		"number of segments to scan the source table in, split among the read workers (0 uses one per read worker)",
+	)
+	flagSet.Bool(
+		autoKey,
+		false,
+		"pick the segments, read and write workers and buffer size from the size, item count and capacity of the "+
+			"tables (the segments, reader-count and writer-count flags override the picked values)",
+	)
+	flagSet.Int64(
+		maxBufferKey,
+		0,
+		"max estimated bytes of the scanned items waiting to be written, pausing the scans while exceeded "+
+			"(0 only bounds the number of buffered pages)",
+	)
+	flagSet.String(
+		onDemandKey,
+		string(dynamodbcopy.OnDemandKeep),
+		"how to handle on-demand tables: keep (rate limit the copy with the capacity flags) or switch "+
+			"(switch to provisioned with the capacity flags during the copy)",
+	)
+	flagSet.Bool(
+		autoScalingKey,
+		false,
+		"raise the min capacity of the tables auto scaling targets during the copy, restoring them afterwards "+
+			"(requires the application-autoscaling permissions)",
+	)
+	flagSet.String(
+		decreaseKey,
+		string(dynamodbcopy.DecreaseWarn),
+		"how to handle raising the capacity of tables already decreased too many times today to be restored: "+
+			"warn (raise anyway), skip (keep the current capacity) or fail",
+	)
+	flagSet.Int(
+		rampStepKey,
+		0,
+		"raise the capacity in steps of this many units up to the capacity flags as throughput is consumed "+
+			"during the copy (0 raises it at once)",
+	)
+	flagSet.Duration(rampIntervalKey, time.Minute, "interval between capacity ramp steps")
+	flagSet.Int(
+		rampDownKey,
+		1,
+		"number of steps to lower the ramped capacity in after the copy (each step uses one of the daily decreases)",
+	)
+	flagSet.Bool(
+		dryRunKey,
+		false,
+		"describe the tables and print the provisioning changes and estimates of the copy, without performing it",
+	)
+	flagSet.Bool(
+		forceKey,
+		false,
+		"allow copying into a non-empty target table with the overwrite conflict mode, "+
+			"the other conflict modes don't overwrite the existing items",
+	)
+	flagSet.StringSlice(protectedKey, nil, "target table name patterns to refuse copying into (e.g. prod-*)")
+	flagSet.StringSlice(requiredTagsKey, nil, "tags (key=value) the target table must have to copy into it")
+	flagSet.BoolP(yesKey, "y", false, "skip the interactive confirmation of the copy")
+	flagSet.String(
+		onConflictKey,
+		string(dynamodbcopy.ConflictOverwrite),
+		"how to handle items that already exist in the target table: overwrite, skip, fail or newer-wins "+
+			"(overwrite only items older than the source items according to the newer-attribute flag)",
+	)
+	flagSet.String(newerKey, "", "attribute (e.g. updatedAt or version) compared by the newer-wins conflict mode")
+	flagSet.Bool(
+		deleteKey,
+		false,
+		"mirror the source table, deleting the target items that don't exist in the source table after the copy",
+	)
+	flagSet.Bool(
+		settingsKey,
+		false,
+		"copy the time to live, point in time recovery, stream, deletion protection, contributor insights and tags "+
+			"of the source table after the copy",
+	)
+	flagSet.String(metricsAddrKey, "", "address (e.g. :9090) to expose Prometheus metrics of the copy on /metrics")
+	flagSet.String(
+		traceExporterKey,
+		"",
+		"export OpenTelemetry traces of the copy with OTLP over HTTP (otlp) or into a JSON file (file)",
+	)
+	flagSet.String(
+		traceTargetKey,
+		"",
+		"OTLP endpoint URL (e.g. http://localhost:4318, defaults to the OTEL_EXPORTER_OTLP_* environment variables) "+
+			"or file path the traces are exported to",
+	)
+	clientflags.BindFlags(flagSet)
 	flagSet.BoolP(debugKey, "d", false, "enable debug logs")
 }
 
@@ -57,7 +173,23 @@ func runHandler(logger dynamodbcopy.Logger) func(cmd *cobra.Command, args []stri
 			return handleError("error setting up dependencies", err)
 		}
 
-		return run(deps)
+		if deps.MetricsAddr != "" {
+			server, err := deps.Metrics.Serve(deps.MetricsAddr, logger)
+			if err != nil {
+				return handleError("error serving metrics", err)
+			}
+			defer server.Close()
+		}
+
+		endCopy := deps.Tracing.StartCopy(args[0], args[1])
+		err = run(deps)
+		endCopy(err)
+
+		if tracingErr := deps.Tracing.Shutdown(); tracingErr != nil {
+			logger.Printf("[%s] error exporting traces: %s", cmdName, tracingErr)
+		}
+
+		return err
 	}
 }
 
@@ -67,35 +199,106 @@ func run(deps dependencies) error {
 		return handleError("error fetching initial provisioning", err)
 	}
 
-	updateProvisioning := deps.Config.Provisioning(initialProvisioning)
-	if _, err := deps.Provisioner.Update(updateProvisioning); err != nil {
+	if deps.DryRun {
+		return dryRun(deps, initialProvisioning)
+	}
+
+	if err := deps.Guard.Check(); err != nil {
+		return handleError("refusing to copy", err)
+	}
+
+	updateProvisioning, err := deps.Ramp.Start(initialProvisioning)
+	if err != nil {
 		return handleError("error setting up provisioning before copy", err)
 	}
 
-	if err := deps.Copier.Copy(deps.Config.Workers()); err != nil {
-		copyErr := handleError("error copying records", err)
-		if _, provisionErr := deps.Provisioner.Update(initialProvisioning); provisionErr != nil {
-			return handleError(copyErr.Error(), provisionErr)
-		}
+	readLimit, writeLimit := deps.Config.RateLimits(updateProvisioning)
+	deps.SourceLimiter.SetLimit(readLimit)
+	deps.TargetLimiter.SetLimit(writeLimit)
+
+	if err := copyItems(deps); err != nil {
+		return restoreAfterError(deps, initialProvisioning, err)
+	}
 
-		return copyErr
+	if err := deps.Ramp.RampDown(); err != nil {
+		return restoreAfterError(deps, initialProvisioning, handleError("error ramping down provisioning", err))
 	}
 
 	if _, err := deps.Provisioner.Update(initialProvisioning); err != nil {
 		return handleError("error restoring initial provisioning", err)
 	}
 
+	if deps.Settings == nil {
+		return nil
+	}
+
+	if err := deps.Settings.CopySettings(); err != nil {
+		return handleError("error copying table settings", err)
+	}
+
+	return nil
+}
+
+func copyItems(deps dependencies) error {
+	defer deps.Report.Print(deps.Logger)
+	defer deps.Ramp.Stop()
+
+	if err := deps.Copier.Copy(deps.Config.Workers()); err != nil {
+		return handleError("error copying records", err)
+	}
+
+	if deps.Mirror == nil {
+		return nil
+	}
+
+	readers, _ := deps.Config.Workers()
+	if err := deps.Mirror.DeleteExtraneous(readers); err != nil {
+		return handleError("error deleting extraneous records", err)
+	}
+
+	return nil
+}
+
+func dryRun(deps dependencies, initialProvisioning dynamodbcopy.Provisioning) error {
+	plan, err := deps.Planner.Plan(initialProvisioning)
+	if err != nil {
+		return handleError("error planning copy", err)
+	}
+
+	plan.Print(deps.Logger)
+
 	return nil
 }
 
+func restoreAfterError(deps dependencies, initialProvisioning dynamodbcopy.Provisioning, err error) error {
+	if _, provisionErr := deps.Provisioner.Update(initialProvisioning); provisionErr != nil {
+		return handleError(err.Error(), provisionErr)
+	}
+
+	return err
+}
+
 func handleError(msg string, err error) error {
 	return fmt.Errorf("[%s] %s: %s", cmdName, msg, err)
 }
 
 type dependencies struct {
-	Copier      dynamodbcopy.Copier
-	Provisioner dynamodbcopy.Provisioner
-	Config      dynamodbcopy.Config
+	Copier        dynamodbcopy.Copier
+	Provisioner   dynamodbcopy.Provisioner
+	Config        dynamodbcopy.Config
+	Ramp          *dynamodbcopy.Ramp
+	Planner       dynamodbcopy.Planner
+	Guard         dynamodbcopy.Guard
+	Report        *dynamodbcopy.WriteReport
+	Mirror        dynamodbcopy.Mirror
+	Settings      dynamodbcopy.SettingsCopier
+	Metrics       *dynamodbcopy.Metrics
+	Tracing       *dynamodbcopy.Tracing
+	SourceLimiter *dynamodbcopy.RateLimiter
+	TargetLimiter *dynamodbcopy.RateLimiter
+	Logger        dynamodbcopy.Logger
+	DryRun        bool
+	MetricsAddr   string
 }
 
 func setupDependencies(cmd *cobra.Command, args []string, logger dynamodbcopy.Logger) (dependencies, error) {
@@ -112,35 +315,224 @@ func setupDependencies(cmd *cobra.Command, args []string, logger dynamodbcopy.Lo
 		logger,
 		config.GetBool(debugKey),
 	)
+
+	var metrics *dynamodbcopy.Metrics
+	if config.GetString(metricsAddrKey) != "" {
+		metrics = dynamodbcopy.NewMetrics()
+	}
+
+	var tracing *dynamodbcopy.Tracing
+	if config.GetString(traceExporterKey) != "" {
+		exporter, err := dynamodbcopy.NewTraceExporter(config.GetString(traceExporterKey), config.GetString(traceTargetKey))
+		if err != nil {
+			return dependencies{}, err
+		}
+		tracing = dynamodbcopy.NewTracing(exporter)
+	}
+
+	serviceOptions, err := clientflags.ServiceOptions(config)
+	if err != nil {
+		return dependencies{}, err
+	}
+
+	serviceOptions = append(serviceOptions, dynamodbcopy.WithMetrics(metrics), dynamodbcopy.WithTracing(tracing))
+
 	srcTableService := dynamodbcopy.NewDynamoDBService(
 		config.GetString(srcTableKey),
-		dynamodbcopy.NewDynamoClient(config.GetString(srcRoleArnKey)),
-		dynamodbcopy.RandomSleeper,
+		clientflags.NewDynamoClient(config, config.GetString(srcRoleArnKey), logger),
+		dynamodbcopy.Sleep,
 		debugLogger,
+		serviceOptions...,
 	)
 	trgTableService := dynamodbcopy.NewDynamoDBService(
 		config.GetString(trgTableKey),
-		dynamodbcopy.NewDynamoClient(config.GetString(trgRoleArnKey)),
-		dynamodbcopy.RandomSleeper,
+		clientflags.NewDynamoClient(config, config.GetString(trgRoleArnKey), logger),
+		dynamodbcopy.Sleep,
 		debugLogger,
+		serviceOptions...,
 	)
 
-	copier := dynamodbcopy.NewCopier(
+	onDemandPolicy, err := dynamodbcopy.ParseOnDemandPolicy(config.GetString(onDemandKey))
+	if err != nil {
+		return dependencies{}, err
+	}
+
+	decreasePolicy, err := dynamodbcopy.ParseDecreasePolicy(config.GetString(decreaseKey))
+	if err != nil {
+		return dependencies{}, err
+	}
+
+	requiredTags, err := dynamodbcopy.ParseTags(config.GetStringSlice(requiredTagsKey))
+	if err != nil {
+		return dependencies{}, err
+	}
+
+	conflictMode, err := dynamodbcopy.ParseConflictMode(config.GetString(onConflictKey))
+	if err != nil {
+		return dependencies{}, err
+	}
+
+	if conflictMode == dynamodbcopy.ConflictNewerWins && config.GetString(newerKey) == "" {
+		return dependencies{}, fmt.Errorf("the %s conflict mode requires the %s flag", conflictMode, newerKey)
+	}
+
+	var confirm dynamodbcopy.Confirmer
+	if !config.GetBool(yesKey) {
+		confirm = dynamodbcopy.NewConfirmer(os.Stdin, os.Stderr)
+	}
+
+	report := dynamodbcopy.NewWriteReport()
+	srcLimiter := dynamodbcopy.NewRateLimiter(dynamodbcopy.Sleep)
+	trgLimiter := dynamodbcopy.NewRateLimiter(dynamodbcopy.Sleep)
+
+	var mirror dynamodbcopy.Mirror
+	copySrcTableService := srcTableService
+	if config.GetBool(deleteKey) {
+		srcKeys := dynamodbcopy.NewKeySet()
+		copySrcTableService, err = dynamodbcopy.NewKeyRecordingDynamoDBService(srcTableService, srcKeys)
+		if err != nil {
+			return dependencies{}, err
+		}
+		mirror = dynamodbcopy.NewMirror(trgTableService, srcKeys, report, debugLogger)
+	}
+
+	sizing := dynamodbcopy.Sizing{
+		Segments:   config.GetInt(segmentsKey),
+		Readers:    config.GetInt(readerCountKey),
+		Writers:    config.GetInt(writerCountKey),
+		BufferSize: config.GetInt(writerCountKey),
+	}
+	if config.GetBool(autoKey) {
+		sizing, err = autoSize(
+			cmd.Flags(),
+			dynamodbcopy.NewSizer(srcTableService, trgTableService, logger),
+			dynamodbcopy.NewConfig(config.GetInt(readCapacityKey), config.GetInt(writeCapacityKey), 0, 0),
+			sizing,
+		)
+		if err != nil {
+			return dependencies{}, err
+		}
+	}
+
+	copierChan := dynamodbcopy.NewBoundedCopierChan(sizing.BufferSize, config.GetInt64(maxBufferKey))
+	metrics.RegisterBacklog(copierChan.Items)
+
+	copier := dynamodbcopy.NewSegmentedCopier(
+		dynamodbcopy.NewRateLimitedDynamoDBService(copySrcTableService, srcLimiter),
+		dynamodbcopy.NewRateLimitedDynamoDBService(
+			dynamodbcopy.NewConflictResolvingDynamoDBService(
+				trgTableService,
+				conflictMode,
+				config.GetString(newerKey),
+				report,
+				debugLogger,
+			),
+			trgLimiter,
+		),
+		copierChan,
+		sizing.Segments,
+		debugLogger,
+	)
+	provisioner := dynamodbcopy.NewProvisioner(srcTableService, trgTableService, decreasePolicy, debugLogger)
+	if config.GetBool(autoScalingKey) {
+		provisioner = dynamodbcopy.NewAutoScalingProvisioner(
+			provisioner,
+			dynamodbcopy.NewAutoScalingService(
+				config.GetString(srcTableKey),
+				dynamodbcopy.NewAutoScalingClient(config.GetString(srcRoleArnKey)),
+				debugLogger,
+			),
+			dynamodbcopy.NewAutoScalingService(
+				config.GetString(trgTableKey),
+				dynamodbcopy.NewAutoScalingClient(config.GetString(trgRoleArnKey)),
+				debugLogger,
+			),
+			debugLogger,
+		)
+	}
+	if metrics != nil {
+		provisioner = dynamodbcopy.NewMetricsProvisioner(provisioner, metrics)
+	}
+
+	copyConfig := dynamodbcopy.NewConfig(
+		config.GetInt(readCapacityKey),
+		config.GetInt(writeCapacityKey),
+		sizing.Readers,
+		sizing.Writers,
+	).WithOnDemandPolicy(onDemandPolicy).WithRamp(
+		int64(config.GetInt(rampStepKey)),
+		config.GetDuration(rampIntervalKey),
+		config.GetInt(rampDownKey),
+	)
+
+	ramp := dynamodbcopy.NewRamp(
+		provisioner,
 		srcTableService,
 		trgTableService,
-		dynamodbcopy.NewCopierChan(config.GetInt(writerCountKey)),
+		copyConfig,
+		dynamodbcopy.RandomSleeper,
 		debugLogger,
 	)
-	provisioner := dynamodbcopy.NewProvisioner(srcTableService, trgTableService, debugLogger)
+
+	var settings dynamodbcopy.SettingsCopier
+	if config.GetBool(settingsKey) {
+		settings = dynamodbcopy.NewSettingsCopier(srcTableService, trgTableService, debugLogger)
+	}
+
+	guard := dynamodbcopy.NewGuard(
+		srcTableService,
+		trgTableService,
+		// only the overwrite conflict mode overwrites the items of a non-empty target table
+		config.GetBool(forceKey) || conflictMode != dynamodbcopy.ConflictOverwrite,
+		config.GetStringSlice(protectedKey),
+		requiredTags,
+		confirm,
+	)
 
 	return dependencies{
-		Copier:      copier,
-		Provisioner: provisioner,
-		Config: dynamodbcopy.NewConfig(
-			config.GetInt(readCapacityKey),
-			config.GetInt(writeCapacityKey),
-			config.GetInt(readerCountKey),
-			config.GetInt(writerCountKey),
-		),
+		Copier:        copier,
+		Provisioner:   provisioner,
+		Config:        copyConfig,
+		Ramp:          ramp,
+		Planner:       dynamodbcopy.NewPlanner(srcTableService, trgTableService, copyConfig),
+		Guard:         guard,
+		Report:        report,
+		Mirror:        mirror,
+		Settings:      settings,
+		Metrics:       metrics,
+		Tracing:       tracing,
+		SourceLimiter: srcLimiter,
+		TargetLimiter: trgLimiter,
+		Logger:        logger,
+		DryRun:        config.GetBool(dryRunKey),
+		MetricsAddr:   config.GetString(metricsAddrKey),
 	}, nil
 }
+
+// autoSize picks the Sizing of the copy with the Sizer, overriding the picked values with the manual Sizing values of
+// the segments, reader-count and writer-count flags that were set. The buffer size follows the final writer count
+func autoSize(
+	flagSet *pflag.FlagSet,
+	sizer dynamodbcopy.Sizer,
+	config dynamodbcopy.Config,
+	manual dynamodbcopy.Sizing,
+) (dynamodbcopy.Sizing, error) {
+	sizing, err := sizer.Size(config)
+	if err != nil {
+		return dynamodbcopy.Sizing{}, err
+	}
+
+	if flagSet.Changed(segmentsKey) {
+		sizing.Segments = manual.Segments
+	}
+
+	if flagSet.Changed(readerCountKey) {
+		sizing.Readers = manual.Readers
+	}
+
+	if flagSet.Changed(writerCountKey) {
+		sizing = sizing.WithWriters(manual.Writers)
+	}
+
+	return sizing, nil
+}
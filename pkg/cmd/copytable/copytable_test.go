@@ -2,9 +2,11 @@ package copytable
 
 import (
 	"errors"
+	"io/ioutil"
 	"log"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
@@ -22,22 +24,32 @@ func TestRun(t *testing.T) {
 
 	testCases := []struct {
 		subTestName string
-		mocker      func(copier *mocks.Copier, provisioner *mocks.Provisioner)
+		mocker      func(copier *mocks.Copier, provisioner *mocks.Provisioner, guard *mocks.Guard)
 		expectError bool
 		config      dynamodbcopy.Config
 	}{
 		{
 			"FetchProvisioningError",
-			func(copier *mocks.Copier, provisioner *mocks.Provisioner) {
+			func(copier *mocks.Copier, provisioner *mocks.Provisioner, guard *mocks.Guard) {
 				provisioner.On("Fetch").Return(dynamodbcopy.Provisioning{}, expectedError).Once()
 			},
 			true,
 			defaultConfig,
 		},
+		{
+			"GuardError",
+			func(copier *mocks.Copier, provisioner *mocks.Provisioner, guard *mocks.Guard) {
+				provisioner.On("Fetch").Return(defaultProvision, nil).Once()
+				guard.On("Check").Return(expectedError).Once()
+			},
+			true,
+			defaultConfig,
+		},
 		{
 			"UpdateError",
-			func(copier *mocks.Copier, provisioner *mocks.Provisioner) {
+			func(copier *mocks.Copier, provisioner *mocks.Provisioner, guard *mocks.Guard) {
 				provisioner.On("Fetch").Return(defaultProvision, nil).Once()
+				guard.On("Check").Return(nil).Once()
 				provisioner.On("Update", defaultProvision).Return(defaultProvision, expectedError).Once()
 			},
 			true,
@@ -45,8 +57,9 @@ func TestRun(t *testing.T) {
 		},
 		{
 			"CopyError",
-			func(copier *mocks.Copier, provisioner *mocks.Provisioner) {
+			func(copier *mocks.Copier, provisioner *mocks.Provisioner, guard *mocks.Guard) {
 				provisioner.On("Fetch").Return(defaultProvision, nil).Once()
+				guard.On("Check").Return(nil).Once()
 				provisioner.On("Update", defaultProvision).Return(defaultProvision, nil).Twice()
 				copier.On("Copy", 1, 1).Return(expectedError).Once()
 			},
@@ -55,8 +68,9 @@ func TestRun(t *testing.T) {
 		},
 		{
 			"CopyErrorWithRestoreError",
-			func(copier *mocks.Copier, provisioner *mocks.Provisioner) {
+			func(copier *mocks.Copier, provisioner *mocks.Provisioner, guard *mocks.Guard) {
 				provisioner.On("Fetch").Return(defaultProvision, nil).Once()
+				guard.On("Check").Return(nil).Once()
 				provisioner.On("Update", defaultProvision).Return(defaultProvision, nil).Once()
 				copier.On("Copy", 1, 1).Return(expectedError).Once()
 				provisioner.On("Update", defaultProvision).Return(defaultProvision, expectedError).Once()
@@ -66,8 +80,9 @@ func TestRun(t *testing.T) {
 		},
 		{
 			"RestoreProvisioningError",
-			func(copier *mocks.Copier, provisioner *mocks.Provisioner) {
+			func(copier *mocks.Copier, provisioner *mocks.Provisioner, guard *mocks.Guard) {
 				provisioner.On("Fetch").Return(defaultProvision, nil).Once()
+				guard.On("Check").Return(nil).Once()
 				provisioner.On("Update", defaultProvision).Return(defaultProvision, nil).Once()
 				copier.On("Copy", 1, 1).Return(nil).Once()
 				provisioner.On("Update", defaultProvision).Return(defaultProvision, expectedError).Once()
@@ -77,8 +92,9 @@ func TestRun(t *testing.T) {
 		},
 		{
 			"Success",
-			func(copier *mocks.Copier, provisioner *mocks.Provisioner) {
+			func(copier *mocks.Copier, provisioner *mocks.Provisioner, guard *mocks.Guard) {
 				provisioner.On("Fetch").Return(defaultProvision, nil).Once()
+				guard.On("Check").Return(nil).Once()
 				provisioner.On("Update", defaultProvision).Return(defaultProvision, nil).Once()
 				copier.On("Copy", 1, 1).Return(nil).Once()
 				provisioner.On("Update", defaultProvision).Return(defaultProvision, nil).Once()
@@ -94,13 +110,176 @@ func TestRun(t *testing.T) {
 			func(st *testing.T) {
 				copierMock := &mocks.Copier{}
 				provisionerMock := &mocks.Provisioner{}
+				guardMock := &mocks.Guard{}
 
-				testCase.mocker(copierMock, provisionerMock)
+				testCase.mocker(copierMock, provisionerMock, guardMock)
+
+				ramp := dynamodbcopy.NewRamp(
+					provisionerMock,
+					&mocks.DynamoDBService{},
+					&mocks.DynamoDBService{},
+					testCase.config,
+					dynamodbcopy.RandomSleeper,
+					log.New(ioutil.Discard, "", log.LstdFlags),
+				)
+
+				deps := dependencies{
+					Copier:        copierMock,
+					Provisioner:   provisionerMock,
+					Config:        testCase.config,
+					Ramp:          ramp,
+					Guard:         guardMock,
+					Report:        dynamodbcopy.NewWriteReport(),
+					Logger:        log.New(ioutil.Discard, "", log.LstdFlags),
+					SourceLimiter: dynamodbcopy.NewRateLimiter(dynamodbcopy.Sleep),
+					TargetLimiter: dynamodbcopy.NewRateLimiter(dynamodbcopy.Sleep),
+				}
+
+				err := run(deps)
+
+				if testCase.expectError {
+					require.NotNil(t, err)
+				} else {
+					require.Nil(t, err)
+				}
+
+				copierMock.AssertExpectations(st)
+				provisionerMock.AssertExpectations(st)
+				guardMock.AssertExpectations(st)
+			},
+		)
+	}
+}
+
+func TestRunCopySettings(t *testing.T) {
+	t.Parallel()
+
+	expectedError := errors.New("copyTable settings error")
+	config := dynamodbcopy.NewConfig(5, 5, 1, 1)
+	defaultProvision := dynamodbcopy.Provisioning{}
+
+	for _, settingsErr := range []error{nil, expectedError} {
+		copierMock := &mocks.Copier{}
+		copierMock.On("Copy", 1, 1).Return(nil).Once()
+		provisionerMock := &mocks.Provisioner{}
+		provisionerMock.On("Fetch").Return(defaultProvision, nil).Once()
+		provisionerMock.On("Update", defaultProvision).Return(defaultProvision, nil).Twice()
+		guardMock := &mocks.Guard{}
+		guardMock.On("Check").Return(nil).Once()
+		settingsMock := &mocks.SettingsCopier{}
+		settingsMock.On("CopySettings").Return(settingsErr).Once()
+
+		deps := dependencies{
+			Copier:      copierMock,
+			Provisioner: provisionerMock,
+			Config:      config,
+			Ramp: dynamodbcopy.NewRamp(
+				provisionerMock,
+				&mocks.DynamoDBService{},
+				&mocks.DynamoDBService{},
+				config,
+				dynamodbcopy.RandomSleeper,
+				log.New(ioutil.Discard, "", log.LstdFlags),
+			),
+			Guard:         guardMock,
+			Report:        dynamodbcopy.NewWriteReport(),
+			Settings:      settingsMock,
+			Logger:        log.New(ioutil.Discard, "", log.LstdFlags),
+			SourceLimiter: dynamodbcopy.NewRateLimiter(dynamodbcopy.Sleep),
+			TargetLimiter: dynamodbcopy.NewRateLimiter(dynamodbcopy.Sleep),
+		}
+
+		err := run(deps)
+
+		assert.Equal(t, settingsErr != nil, err != nil)
+
+		copierMock.AssertExpectations(t)
+		provisionerMock.AssertExpectations(t)
+		settingsMock.AssertExpectations(t)
+	}
+}
+
+func TestCopyItemsMirror(t *testing.T) {
+	t.Parallel()
+
+	expectedError := errors.New("copyTable mirror error")
+	config := dynamodbcopy.NewConfig(5, 5, 3, 1)
+
+	for _, mirrorErr := range []error{nil, expectedError} {
+		copierMock := &mocks.Copier{}
+		copierMock.On("Copy", 3, 1).Return(nil).Once()
+		mirrorMock := &mocks.Mirror{}
+		mirrorMock.On("DeleteExtraneous", 3).Return(mirrorErr).Once()
+
+		deps := dependencies{
+			Copier: copierMock,
+			Config: config,
+			Ramp: dynamodbcopy.NewRamp(
+				&mocks.Provisioner{},
+				&mocks.DynamoDBService{},
+				&mocks.DynamoDBService{},
+				config,
+				dynamodbcopy.RandomSleeper,
+				log.New(ioutil.Discard, "", log.LstdFlags),
+			),
+			Report: dynamodbcopy.NewWriteReport(),
+			Mirror: mirrorMock,
+			Logger: log.New(ioutil.Discard, "", log.LstdFlags),
+		}
+
+		err := copyItems(deps)
+
+		assert.Equal(t, mirrorErr != nil, err != nil)
+
+		copierMock.AssertExpectations(t)
+		mirrorMock.AssertExpectations(t)
+	}
+}
+
+func TestRunDryRun(t *testing.T) {
+	t.Parallel()
+
+	expectedError := errors.New("copyTable plan error")
+	defaultProvision := dynamodbcopy.Provisioning{}
+
+	testCases := []struct {
+		subTestName string
+		mocker      func(planner *mocks.Planner)
+		expectError bool
+	}{
+		{
+			"PlanError",
+			func(planner *mocks.Planner) {
+				planner.On("Plan", defaultProvision).Return(dynamodbcopy.Plan{}, expectedError).Once()
+			},
+			true,
+		},
+		{
+			"Success",
+			func(planner *mocks.Planner) {
+				planner.On("Plan", defaultProvision).Return(dynamodbcopy.Plan{}, nil).Once()
+			},
+			false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.subTestName,
+			func(st *testing.T) {
+				copierMock := &mocks.Copier{}
+				provisionerMock := &mocks.Provisioner{}
+				provisionerMock.On("Fetch").Return(defaultProvision, nil).Once()
+				plannerMock := &mocks.Planner{}
+
+				testCase.mocker(plannerMock)
 
 				deps := dependencies{
 					Copier:      copierMock,
 					Provisioner: provisionerMock,
-					Config:      testCase.config,
+					Planner:     plannerMock,
+					Logger:      log.New(ioutil.Discard, "", log.LstdFlags),
+					DryRun:      true,
 				}
 
 				err := run(deps)
@@ -113,6 +292,7 @@ func TestRun(t *testing.T) {
 
 				copierMock.AssertExpectations(st)
 				provisionerMock.AssertExpectations(st)
+				plannerMock.AssertExpectations(st)
 			},
 		)
 	}
@@ -131,11 +311,39 @@ func TestBindFlags(t *testing.T) {
 	require.NotNil(t, cmd.Flag("write-capacity"))
 	require.NotNil(t, cmd.Flag("reader-count"))
 	require.NotNil(t, cmd.Flag("writer-count"))
+	require.NotNil(t, cmd.Flag("segments"))
+	require.NotNil(t, cmd.Flag("auto"))
+	require.NotNil(t, cmd.Flag("max-buffer-bytes"))
+	require.NotNil(t, cmd.Flag("on-demand"))
+	require.NotNil(t, cmd.Flag("auto-scaling"))
+	require.NotNil(t, cmd.Flag("decrease-policy"))
+	require.NotNil(t, cmd.Flag("ramp-step"))
+	require.NotNil(t, cmd.Flag("ramp-interval"))
+	require.NotNil(t, cmd.Flag("ramp-down-steps"))
+	require.NotNil(t, cmd.Flag("dry-run"))
+	require.NotNil(t, cmd.Flag("force"))
+	require.NotNil(t, cmd.Flag("protected-tables"))
+	require.NotNil(t, cmd.Flag("required-target-tags"))
+	require.NotNil(t, cmd.Flag("yes"))
+	require.NotNil(t, cmd.Flag("on-conflict"))
+	require.NotNil(t, cmd.Flag("newer-attribute"))
+	require.NotNil(t, cmd.Flag("delete-extraneous"))
+	require.NotNil(t, cmd.Flag("copy-settings"))
+	require.NotNil(t, cmd.Flag("metrics-addr"))
+	require.NotNil(t, cmd.Flag("trace-exporter"))
+	require.NotNil(t, cmd.Flag("trace-target"))
+	require.NotNil(t, cmd.Flag("retry-max-attempts"))
+	require.NotNil(t, cmd.Flag("retry-max-elapsed"))
+	require.NotNil(t, cmd.Flag("retry-base-delay"))
+	require.NotNil(t, cmd.Flag("retry-max-delay"))
+	require.NotNil(t, cmd.Flag("retry-jitter"))
+	require.NotNil(t, cmd.Flag("table-wait-max-elapsed"))
+	require.NotNil(t, cmd.Flag("session-duration"))
 	require.NotNil(t, cmd.Flag("debug"))
 }
 
 func TestSetupDependencies(t *testing.T) {
-	expectedConfig := dynamodbcopy.NewConfig(0, 0, 1, 1)
+	expectedConfig := dynamodbcopy.NewConfig(0, 0, 1, 1).WithRamp(0, time.Minute, 1)
 
 	cmd := &cobra.Command{}
 
@@ -146,6 +354,95 @@ func TestSetupDependencies(t *testing.T) {
 	require.Nil(t, err)
 	require.NotNil(t, deps.Provisioner)
 	require.NotNil(t, deps.Copier)
+	require.NotNil(t, deps.Ramp)
+	require.NotNil(t, deps.Planner)
+	require.NotNil(t, deps.Guard)
+	require.NotNil(t, deps.Report)
+	require.NotNil(t, deps.SourceLimiter)
+	require.NotNil(t, deps.TargetLimiter)
 
 	assert.Equal(t, expectedConfig, deps.Config)
 }
+
+func TestSetupDependenciesInvalidFlags(t *testing.T) {
+	for _, flag := range []string{"on-demand", "decrease-policy", "required-target-tags", "on-conflict"} {
+		cmd := &cobra.Command{}
+
+		bindFlags(cmd.Flags())
+		require.Nil(t, cmd.Flags().Set(flag, "invalid"))
+
+		_, err := setupDependencies(cmd, []string{"src", "trg"}, log.New(os.Stdout, "", log.LstdFlags))
+
+		require.NotNil(t, err, flag)
+	}
+}
+
+func TestSetupDependenciesNewerWinsWithoutAttribute(t *testing.T) {
+	cmd := &cobra.Command{}
+
+	bindFlags(cmd.Flags())
+	require.Nil(t, cmd.Flags().Set("on-conflict", "newer-wins"))
+
+	_, err := setupDependencies(cmd, []string{"src", "trg"}, log.New(os.Stdout, "", log.LstdFlags))
+	require.NotNil(t, err)
+
+	require.Nil(t, cmd.Flags().Set("newer-attribute", "updatedAt"))
+
+	_, err = setupDependencies(cmd, []string{"src", "trg"}, log.New(os.Stdout, "", log.LstdFlags))
+	require.Nil(t, err)
+}
+
+func TestAutoSize(t *testing.T) {
+	t.Parallel()
+
+	expectedError := errors.New("size error")
+	config := dynamodbcopy.NewConfig(10, 20, 0, 0)
+	autoSizing := dynamodbcopy.Sizing{Segments: 50, Readers: 8, Writers: 20, BufferSize: 40}
+	manualSizing := dynamodbcopy.Sizing{Segments: 100, Readers: 4, Writers: 2, BufferSize: 2}
+
+	testCases := []struct {
+		subTestName    string
+		flags          []string
+		sizeErr        error
+		expectedSizing dynamodbcopy.Sizing
+	}{
+		{"Error", nil, expectedError, dynamodbcopy.Sizing{}},
+		{"Auto", nil, nil, autoSizing},
+		{
+			"ManualSegments",
+			[]string{"segments"},
+			nil,
+			dynamodbcopy.Sizing{Segments: 100, Readers: 8, Writers: 20, BufferSize: 40},
+		},
+		{
+			"ManualWorkers",
+			[]string{"reader-count", "writer-count"},
+			nil,
+			dynamodbcopy.Sizing{Segments: 50, Readers: 4, Writers: 2, BufferSize: 4},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(
+			testCase.subTestName,
+			func(st *testing.T) {
+				cmd := &cobra.Command{}
+				bindFlags(cmd.Flags())
+				for _, flag := range testCase.flags {
+					require.Nil(st, cmd.Flags().Set(flag, "1"))
+				}
+
+				sizer := &mocks.Sizer{}
+				sizer.On("Size", config).Return(autoSizing, testCase.sizeErr).Once()
+
+				sizing, err := autoSize(cmd.Flags(), sizer, config, manualSizing)
+
+				assert.Equal(st, testCase.sizeErr, err)
+				assert.Equal(st, testCase.expectedSizing, sizing)
+
+				sizer.AssertExpectations(st)
+			},
+		)
+	}
+}
@@ -0,0 +1,179 @@
+package count
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"github.com/uniplaces/dynamodbcopy"
+	"github.com/uniplaces/dynamodbcopy/pkg/cmd/clientflags"
+)
+
+const (
+	cmdName          = "count"
+	shortDescription = "Counts (or profiles) the dynamoDB records of a table, optionally matching a filter"
+)
+
+const (
+	tableKey       = "table"
+	roleArnKey     = "role-arn"
+	filterKey      = "filter"
+	indexKey       = "index"
+	namesKey       = "names"
+	valuesKey      = "values"
+	readerCountKey = "reader-count"
+	writerCountKey = "writer-count"
+	profileKey     = "profile"
+	largestKey     = "largest"
+	debugKey       = "debug"
+)
+
+// New creates a new instance of the count command
+func New(logger dynamodbcopy.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("%s <table>", cmdName),
+		Short: shortDescription,
+		Args:  cobra.ExactArgs(1),
+		RunE:  runHandler(logger),
+	}
+
+	bindFlags(cmd.Flags())
+
+	return cmd
+}
+
+func bindFlags(flagSet *pflag.FlagSet) {
+	flagSet.String(roleArnKey, "", "role arn that allows to read from the table")
+	flagSet.String(filterKey, "", "filter expression of the items to count (e.g. \"expiresAt < :now\")")
+	flagSet.String(indexKey, "", "secondary index to scan")
+	flagSet.String(namesKey, "", "expression attribute names in JSON (e.g. {\"#tenant\": \"tenant\"})")
+	flagSet.String(
+		valuesKey,
+		"",
+		"expression attribute values in DynamoDB JSON (e.g. {\":now\": {\"N\": \"1546300800\"}})",
+	)
+	flagSet.IntP(readerCountKey, "r", 1, "number of read workers (scan segments) to use")
+	flagSet.IntP(writerCountKey, "w", 1, "number of workers profiling the scanned items")
+	flagSet.Bool(
+		profileKey,
+		false,
+		"read the items to report their size histogram, largest items and the frequency and types of each attribute",
+	)
+	flagSet.Int(largestKey, 10, "number of largest items to report when profiling")
+	clientflags.BindFlags(flagSet)
+	flagSet.BoolP(debugKey, "d", false, "enable debug logs")
+}
+
+func runHandler(logger dynamodbcopy.Logger) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		deps, err := setupDependencies(cmd, args, logger)
+		if err != nil {
+			return handleError("error setting up dependencies", err)
+		}
+
+		return run(deps)
+	}
+}
+
+func run(deps dependencies) error {
+	readers, writers := deps.Config.Workers()
+
+	if deps.Profile {
+		profile, err := deps.Counter.Profile(readers, writers)
+		if err != nil {
+			return handleError("error profiling items", err)
+		}
+		profile.Print(deps.Logger)
+
+		return nil
+	}
+
+	count, err := deps.Counter.Count(readers)
+	if err != nil {
+		return handleError("error counting items", err)
+	}
+	deps.Logger.Printf("%d items", count)
+
+	return nil
+}
+
+func handleError(msg string, err error) error {
+	return fmt.Errorf("[%s] %s: %s", cmdName, msg, err)
+}
+
+type dependencies struct {
+	Counter dynamodbcopy.Counter
+	Config  dynamodbcopy.Config
+	Logger  dynamodbcopy.Logger
+	Profile bool
+}
+
+func setupDependencies(cmd *cobra.Command, args []string, logger dynamodbcopy.Logger) (dependencies, error) {
+	config := viper.New()
+
+	config.SetDefault(tableKey, args[0])
+
+	if err := config.BindPFlags(cmd.Flags()); err != nil {
+		return dependencies{}, err
+	}
+
+	names, err := dynamodbcopy.ParseAttributeNames(config.GetString(namesKey))
+	if err != nil {
+		return dependencies{}, err
+	}
+
+	values, err := dynamodbcopy.ParseAttributeValues(config.GetString(valuesKey))
+	if err != nil {
+		return dependencies{}, err
+	}
+
+	largest := config.GetInt(largestKey)
+	if largest < 0 {
+		return dependencies{}, fmt.Errorf("invalid %s %d: expected 0 or more items", largestKey, largest)
+	}
+
+	options := dynamodbcopy.ScanOptions{
+		IndexName:                 config.GetString(indexKey),
+		FilterExpression:          config.GetString(filterKey),
+		ExpressionAttributeNames:  names,
+		ExpressionAttributeValues: values,
+	}
+
+	serviceOptions, err := clientflags.ServiceOptions(config)
+	if err != nil {
+		return dependencies{}, err
+	}
+
+	debugLogger := dynamodbcopy.NewDebugLogger(
+		logger,
+		config.GetBool(debugKey),
+	)
+	tableService := dynamodbcopy.NewDynamoDBService(
+		config.GetString(tableKey),
+		clientflags.NewDynamoClient(config, config.GetString(roleArnKey), logger),
+		dynamodbcopy.Sleep,
+		debugLogger,
+		serviceOptions...,
+	)
+
+	counter := dynamodbcopy.NewCounter(
+		tableService,
+		options,
+		largest,
+		dynamodbcopy.NewCopierChan(config.GetInt(writerCountKey)),
+		debugLogger,
+	)
+
+	return dependencies{
+		Counter: counter,
+		Config: dynamodbcopy.NewConfig(
+			0,
+			0,
+			config.GetInt(readerCountKey),
+			config.GetInt(writerCountKey),
+		),
+		Logger:  logger,
+		Profile: config.GetBool(profileKey),
+	}, nil
+}
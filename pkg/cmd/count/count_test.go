@@ -0,0 +1,151 @@
+package count
+
+import (
+	"errors"
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uniplaces/dynamodbcopy"
+	"github.com/uniplaces/dynamodbcopy/mocks"
+)
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	expectedError := errors.New("count error")
+
+	testCases := []struct {
+		subTestName string
+		profile     bool
+		mocker      func(counter *mocks.Counter)
+		expectError bool
+	}{
+		{
+			"CountError",
+			false,
+			func(counter *mocks.Counter) {
+				counter.On("Count", 2).Return(int64(0), expectedError).Once()
+			},
+			true,
+		},
+		{
+			"Count",
+			false,
+			func(counter *mocks.Counter) {
+				counter.On("Count", 2).Return(int64(10), nil).Once()
+			},
+			false,
+		},
+		{
+			"ProfileError",
+			true,
+			func(counter *mocks.Counter) {
+				counter.On("Profile", 2, 3).Return(nil, expectedError).Once()
+			},
+			true,
+		},
+		{
+			"Profile",
+			true,
+			func(counter *mocks.Counter) {
+				counter.On("Profile", 2, 3).Return(dynamodbcopy.NewProfile([]string{"id"}, 10), nil).Once()
+			},
+			false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.subTestName,
+			func(st *testing.T) {
+				counterMock := &mocks.Counter{}
+
+				testCase.mocker(counterMock)
+
+				deps := dependencies{
+					Counter: counterMock,
+					Config:  dynamodbcopy.NewConfig(0, 0, 2, 3),
+					Logger:  log.New(ioutil.Discard, "", log.LstdFlags),
+					Profile: testCase.profile,
+				}
+
+				err := run(deps)
+
+				if testCase.expectError {
+					require.NotNil(st, err)
+				} else {
+					require.Nil(st, err)
+				}
+
+				counterMock.AssertExpectations(st)
+			},
+		)
+	}
+}
+
+func TestBindFlags(t *testing.T) {
+	t.Parallel()
+
+	cmd := &cobra.Command{}
+
+	bindFlags(cmd.Flags())
+
+	require.NotNil(t, cmd.Flag("role-arn"))
+	require.NotNil(t, cmd.Flag("filter"))
+	require.NotNil(t, cmd.Flag("index"))
+	require.NotNil(t, cmd.Flag("names"))
+	require.NotNil(t, cmd.Flag("values"))
+	require.NotNil(t, cmd.Flag("reader-count"))
+	require.NotNil(t, cmd.Flag("writer-count"))
+	require.NotNil(t, cmd.Flag("profile"))
+	require.NotNil(t, cmd.Flag("largest"))
+	require.NotNil(t, cmd.Flag("retry-max-attempts"))
+	require.NotNil(t, cmd.Flag("retry-max-elapsed"))
+	require.NotNil(t, cmd.Flag("retry-base-delay"))
+	require.NotNil(t, cmd.Flag("retry-max-delay"))
+	require.NotNil(t, cmd.Flag("retry-jitter"))
+	require.NotNil(t, cmd.Flag("table-wait-max-elapsed"))
+	require.NotNil(t, cmd.Flag("session-duration"))
+	require.NotNil(t, cmd.Flag("debug"))
+}
+
+func TestSetupDependencies(t *testing.T) {
+	expectedConfig := dynamodbcopy.NewConfig(0, 0, 1, 1)
+
+	cmd := &cobra.Command{}
+
+	bindFlags(cmd.Flags())
+
+	deps, err := setupDependencies(cmd, []string{"table"}, log.New(os.Stdout, "", log.LstdFlags))
+
+	require.Nil(t, err)
+	require.NotNil(t, deps.Counter)
+
+	assert.Equal(t, expectedConfig, deps.Config)
+	assert.False(t, deps.Profile)
+
+	for _, flag := range []string{"names", "values"} {
+		cmd := &cobra.Command{}
+
+		bindFlags(cmd.Flags())
+		require.Nil(t, cmd.Flags().Set(flag, "invalid"))
+
+		_, err := setupDependencies(cmd, []string{"table"}, log.New(os.Stdout, "", log.LstdFlags))
+
+		require.NotNil(t, err, flag)
+	}
+
+	cmd = &cobra.Command{}
+
+	bindFlags(cmd.Flags())
+	require.Nil(t, cmd.Flags().Set("largest", "-1"))
+
+	_, err = setupDependencies(cmd, []string{"table"}, log.New(os.Stdout, "", log.LstdFlags))
+
+	require.NotNil(t, err)
+}
@@ -0,0 +1,311 @@
+package deleteitems
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"github.com/uniplaces/dynamodbcopy"
+	"github.com/uniplaces/dynamodbcopy/pkg/cmd/clientflags"
+)
+
+const (
+	cmdName          = "delete-items"
+	shortDescription = "Deletes the dynamoDB records of a table that match a filter"
+)
+
+const (
+	tableKey         = "table"
+	roleArnKey       = "role-arn"
+	filterKey        = "filter"
+	keyConditionKey  = "key-condition"
+	indexKey         = "index"
+	namesKey         = "names"
+	valuesKey        = "values"
+	backupKey        = "backup"
+	dryRunKey        = "dry-run"
+	readCapacityKey  = "read-capacity"
+	writeCapacityKey = "write-capacity"
+	readerCountKey   = "reader-count"
+	writerCountKey   = "writer-count"
+	autoScalingKey   = "auto-scaling"
+	decreaseKey      = "decrease-policy"
+	yesKey           = "yes"
+	debugKey         = "debug"
+)
+
+// New creates a new instance of the delete-items command
+func New(logger dynamodbcopy.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("%s <table>", cmdName),
+		Short: shortDescription,
+		Args:  cobra.ExactArgs(1),
+		RunE:  runHandler(logger),
+	}
+
+	bindFlags(cmd.Flags())
+
+	return cmd
+}
+
+func bindFlags(flagSet *pflag.FlagSet) {
+	flagSet.String(roleArnKey, "", "role arn that allows to read from and delete from the table")
+	flagSet.String(filterKey, "", "filter expression of the items to delete (e.g. \"expiresAt < :now\")")
+	flagSet.String(
+		keyConditionKey,
+		"",
+		"key condition expression of the items to delete, querying the table (or index) instead of scanning it",
+	)
+	flagSet.String(indexKey, "", "secondary index to scan or query")
+	flagSet.String(namesKey, "", "expression attribute names in JSON (e.g. {\"#tenant\": \"tenant\"})")
+	flagSet.String(
+		valuesKey,
+		"",
+		"expression attribute values in DynamoDB JSON (e.g. {\":now\": {\"N\": \"1546300800\"}})",
+	)
+	flagSet.String(backupKey, "", "file to back up the deleted items into, as DynamoDB JSON lines (NDJSON)")
+	flagSet.Bool(dryRunKey, false, "count (and back up) the matching items without deleting them")
+	flagSet.Int(readCapacityKey, 0, "read provisioning capacity to set on the table")
+	flagSet.Int(writeCapacityKey, 0, "write provisioning capacity to set on the table")
+	flagSet.IntP(readerCountKey, "r", 1, "number of read workers to use")
+	flagSet.IntP(writerCountKey, "w", 1, "number of delete workers to use")
+	flagSet.Bool(
+		autoScalingKey,
+		false,
+		"raise the min capacity of the table auto scaling targets during the delete, restoring them afterwards "+
+			"(requires the application-autoscaling permissions)",
+	)
+	flagSet.String(
+		decreaseKey,
+		string(dynamodbcopy.DecreaseWarn),
+		"how to handle raising the capacity of a table already decreased too many times today to be restored: "+
+			"warn (raise anyway), skip (keep the current capacity) or fail",
+	)
+	flagSet.BoolP(yesKey, "y", false, "skip the interactive confirmation of the delete")
+	clientflags.BindFlags(flagSet)
+	flagSet.BoolP(debugKey, "d", false, "enable debug logs")
+}
+
+func runHandler(logger dynamodbcopy.Logger) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		deps, err := setupDependencies(cmd, args, logger)
+		if err != nil {
+			return handleError("error setting up dependencies", err)
+		}
+
+		runErr := run(deps)
+		if deps.Backup != nil {
+			if err := deps.Backup.Close(); err != nil && runErr == nil {
+				return handleError("error closing backup file", err)
+			}
+		}
+
+		return runErr
+	}
+}
+
+func run(deps dependencies) error {
+	if deps.DryRun {
+		if err := deps.Backup.Open(); err != nil {
+			return handleError("error creating backup file", err)
+		}
+
+		matched, err := deps.Deleter.Delete(deps.Config.Workers())
+		if err != nil {
+			return handleError("error counting items", err)
+		}
+		deps.Logger.Printf("%d items match (dry run, no items were deleted)", matched)
+
+		return nil
+	}
+
+	if err := deps.Guard.Check(); err != nil {
+		return handleError("refusing to delete items", err)
+	}
+
+	// the backup file is only created once the delete is confirmed, so that an existing file is kept otherwise
+	if err := deps.Backup.Open(); err != nil {
+		return handleError("error creating backup file", err)
+	}
+
+	initialProvisioning, err := deps.Provisioner.Fetch()
+	if err != nil {
+		return handleError("error fetching initial provisioning", err)
+	}
+
+	if _, err := deps.Provisioner.Update(deps.Config.TableProvisioning(initialProvisioning)); err != nil {
+		return handleError("error setting up provisioning before delete", err)
+	}
+
+	deleted, err := deps.Deleter.Delete(deps.Config.Workers())
+	deps.Logger.Printf("deleted %d items", deleted)
+	if err != nil {
+		deleteErr := handleError("error deleting items", err)
+		if _, provisionErr := deps.Provisioner.Update(initialProvisioning); provisionErr != nil {
+			return handleError(deleteErr.Error(), provisionErr)
+		}
+
+		return deleteErr
+	}
+
+	if _, err := deps.Provisioner.Update(initialProvisioning); err != nil {
+		return handleError("error restoring initial provisioning", err)
+	}
+
+	return nil
+}
+
+func handleError(msg string, err error) error {
+	return fmt.Errorf("[%s] %s: %s", cmdName, msg, err)
+}
+
+type dependencies struct {
+	Deleter     dynamodbcopy.ItemDeleter
+	Provisioner dynamodbcopy.Provisioner
+	Guard       dynamodbcopy.Guard
+	Config      dynamodbcopy.Config
+	Backup      *backupFile
+	Logger      dynamodbcopy.Logger
+	DryRun      bool
+}
+
+func setupDependencies(cmd *cobra.Command, args []string, logger dynamodbcopy.Logger) (dependencies, error) {
+	config := viper.New()
+
+	config.SetDefault(tableKey, args[0])
+
+	if err := config.BindPFlags(cmd.Flags()); err != nil {
+		return dependencies{}, err
+	}
+
+	decreasePolicy, err := dynamodbcopy.ParseDecreasePolicy(config.GetString(decreaseKey))
+	if err != nil {
+		return dependencies{}, err
+	}
+
+	names, err := dynamodbcopy.ParseAttributeNames(config.GetString(namesKey))
+	if err != nil {
+		return dependencies{}, err
+	}
+
+	values, err := dynamodbcopy.ParseAttributeValues(config.GetString(valuesKey))
+	if err != nil {
+		return dependencies{}, err
+	}
+
+	filter := dynamodbcopy.ItemFilter{
+		KeyCondition: config.GetString(keyConditionKey),
+		ScanOptions: dynamodbcopy.ScanOptions{
+			IndexName:                 config.GetString(indexKey),
+			FilterExpression:          config.GetString(filterKey),
+			ExpressionAttributeNames:  names,
+			ExpressionAttributeValues: values,
+		},
+	}
+
+	var backup *backupFile
+	var backupWriter io.Writer
+	if path := config.GetString(backupKey); path != "" {
+		backup = &backupFile{path: path}
+		backupWriter = backup
+	}
+
+	serviceOptions, err := clientflags.ServiceOptions(config)
+	if err != nil {
+		return dependencies{}, err
+	}
+
+	debugLogger := dynamodbcopy.NewDebugLogger(
+		logger,
+		config.GetBool(debugKey),
+	)
+	tableService := dynamodbcopy.NewDynamoDBService(
+		config.GetString(tableKey),
+		clientflags.NewDynamoClient(config, config.GetString(roleArnKey), logger),
+		dynamodbcopy.Sleep,
+		debugLogger,
+		serviceOptions...,
+	)
+
+	// the table is both read and deleted from, so it's provisioned once as the source table
+	provisioner := dynamodbcopy.NewTableProvisioner(tableService, decreasePolicy, debugLogger)
+	if config.GetBool(autoScalingKey) {
+		scalingService := dynamodbcopy.NewAutoScalingService(
+			config.GetString(tableKey),
+			dynamodbcopy.NewAutoScalingClient(config.GetString(roleArnKey)),
+			debugLogger,
+		)
+		provisioner = dynamodbcopy.NewAutoScalingProvisioner(provisioner, scalingService, nil, debugLogger)
+	}
+
+	var confirm dynamodbcopy.Confirmer
+	if !config.GetBool(yesKey) {
+		confirm = dynamodbcopy.NewConfirmer(os.Stdin, os.Stderr)
+	}
+
+	deleter := dynamodbcopy.NewItemDeleter(
+		tableService,
+		filter,
+		backupWriter,
+		config.GetBool(dryRunKey),
+		dynamodbcopy.NewCopierChan(config.GetInt(writerCountKey)),
+		debugLogger,
+	)
+
+	return dependencies{
+		Deleter:     deleter,
+		Provisioner: provisioner,
+		Guard:       dynamodbcopy.NewTableGuard(tableService, "delete the matching items from", confirm),
+		Config: dynamodbcopy.NewConfig(
+			config.GetInt(readCapacityKey),
+			config.GetInt(writeCapacityKey),
+			config.GetInt(readerCountKey),
+			config.GetInt(writerCountKey),
+		),
+		Backup: backup,
+		Logger: logger,
+		DryRun: config.GetBool(dryRunKey),
+	}, nil
+}
+
+// backupFile is the file the deleted items are backed up into, only created when opened
+type backupFile struct {
+	path string
+	file *os.File
+}
+
+// Open creates (or truncates) the backup file. A nil backupFile isn't opened
+func (b *backupFile) Open() error {
+	if b == nil || b.file != nil {
+		return nil
+	}
+
+	file, err := os.Create(b.path)
+	if err != nil {
+		return err
+	}
+	b.file = file
+
+	return nil
+}
+
+// Write writes into the backup file, failing unless it was opened
+func (b *backupFile) Write(p []byte) (int, error) {
+	if b.file == nil {
+		return 0, fmt.Errorf("backup file %s is not open", b.path)
+	}
+
+	return b.file.Write(p)
+}
+
+// Close closes the backup file, if it was opened
+func (b *backupFile) Close() error {
+	if b.file == nil {
+		return nil
+	}
+
+	return b.file.Close()
+}
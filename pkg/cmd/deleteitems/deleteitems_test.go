@@ -0,0 +1,270 @@
+package deleteitems
+
+import (
+	"errors"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uniplaces/dynamodbcopy"
+	"github.com/uniplaces/dynamodbcopy/mocks"
+)
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	expectedError := errors.New("delete items error")
+	defaultConfig := dynamodbcopy.NewConfig(5, 5, 2, 3)
+	defaultProvision := dynamodbcopy.Provisioning{}
+
+	testCases := []struct {
+		subTestName string
+		dryRun      bool
+		mocker      func(deleter *mocks.ItemDeleter, provisioner *mocks.Provisioner, guard *mocks.Guard)
+		expectError bool
+	}{
+		{
+			"DryRunError",
+			true,
+			func(deleter *mocks.ItemDeleter, provisioner *mocks.Provisioner, guard *mocks.Guard) {
+				deleter.On("Delete", 2, 3).Return(int64(0), expectedError).Once()
+			},
+			true,
+		},
+		{
+			"DryRun",
+			true,
+			func(deleter *mocks.ItemDeleter, provisioner *mocks.Provisioner, guard *mocks.Guard) {
+				deleter.On("Delete", 2, 3).Return(int64(10), nil).Once()
+			},
+			false,
+		},
+		{
+			"GuardError",
+			false,
+			func(deleter *mocks.ItemDeleter, provisioner *mocks.Provisioner, guard *mocks.Guard) {
+				guard.On("Check").Return(dynamodbcopy.ErrNotConfirmed).Once()
+			},
+			true,
+		},
+		{
+			"FetchProvisioningError",
+			false,
+			func(deleter *mocks.ItemDeleter, provisioner *mocks.Provisioner, guard *mocks.Guard) {
+				guard.On("Check").Return(nil).Once()
+				provisioner.On("Fetch").Return(dynamodbcopy.Provisioning{}, expectedError).Once()
+			},
+			true,
+		},
+		{
+			"UpdateError",
+			false,
+			func(deleter *mocks.ItemDeleter, provisioner *mocks.Provisioner, guard *mocks.Guard) {
+				guard.On("Check").Return(nil).Once()
+				provisioner.On("Fetch").Return(defaultProvision, nil).Once()
+				provisioner.On("Update", defaultProvision).Return(defaultProvision, expectedError).Once()
+			},
+			true,
+		},
+		{
+			"DeleteError",
+			false,
+			func(deleter *mocks.ItemDeleter, provisioner *mocks.Provisioner, guard *mocks.Guard) {
+				guard.On("Check").Return(nil).Once()
+				provisioner.On("Fetch").Return(defaultProvision, nil).Once()
+				provisioner.On("Update", defaultProvision).Return(defaultProvision, nil).Twice()
+				deleter.On("Delete", 2, 3).Return(int64(5), expectedError).Once()
+			},
+			true,
+		},
+		{
+			"RestoreProvisioningError",
+			false,
+			func(deleter *mocks.ItemDeleter, provisioner *mocks.Provisioner, guard *mocks.Guard) {
+				guard.On("Check").Return(nil).Once()
+				provisioner.On("Fetch").Return(defaultProvision, nil).Once()
+				provisioner.On("Update", defaultProvision).Return(defaultProvision, nil).Once()
+				deleter.On("Delete", 2, 3).Return(int64(10), nil).Once()
+				provisioner.On("Update", defaultProvision).Return(defaultProvision, expectedError).Once()
+			},
+			true,
+		},
+		{
+			"Success",
+			false,
+			func(deleter *mocks.ItemDeleter, provisioner *mocks.Provisioner, guard *mocks.Guard) {
+				guard.On("Check").Return(nil).Once()
+				provisioner.On("Fetch").Return(defaultProvision, nil).Once()
+				provisioner.On("Update", defaultProvision).Return(defaultProvision, nil).Twice()
+				deleter.On("Delete", 2, 3).Return(int64(10), nil).Once()
+			},
+			false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.subTestName,
+			func(st *testing.T) {
+				deleterMock := &mocks.ItemDeleter{}
+				provisionerMock := &mocks.Provisioner{}
+				guardMock := &mocks.Guard{}
+
+				testCase.mocker(deleterMock, provisionerMock, guardMock)
+
+				deps := dependencies{
+					Deleter:     deleterMock,
+					Provisioner: provisionerMock,
+					Guard:       guardMock,
+					Config:      defaultConfig,
+					Logger:      log.New(ioutil.Discard, "", log.LstdFlags),
+					DryRun:      testCase.dryRun,
+				}
+
+				err := run(deps)
+
+				if testCase.expectError {
+					require.NotNil(st, err)
+				} else {
+					require.Nil(st, err)
+				}
+
+				deleterMock.AssertExpectations(st)
+				provisionerMock.AssertExpectations(st)
+				guardMock.AssertExpectations(st)
+			},
+		)
+	}
+}
+
+func TestBindFlags(t *testing.T) {
+	t.Parallel()
+
+	cmd := &cobra.Command{}
+
+	bindFlags(cmd.Flags())
+
+	require.NotNil(t, cmd.Flag("role-arn"))
+	require.NotNil(t, cmd.Flag("filter"))
+	require.NotNil(t, cmd.Flag("key-condition"))
+	require.NotNil(t, cmd.Flag("index"))
+	require.NotNil(t, cmd.Flag("names"))
+	require.NotNil(t, cmd.Flag("values"))
+	require.NotNil(t, cmd.Flag("backup"))
+	require.NotNil(t, cmd.Flag("dry-run"))
+	require.NotNil(t, cmd.Flag("read-capacity"))
+	require.NotNil(t, cmd.Flag("write-capacity"))
+	require.NotNil(t, cmd.Flag("reader-count"))
+	require.NotNil(t, cmd.Flag("writer-count"))
+	require.NotNil(t, cmd.Flag("auto-scaling"))
+	require.NotNil(t, cmd.Flag("decrease-policy"))
+	require.NotNil(t, cmd.Flag("yes"))
+	require.NotNil(t, cmd.Flag("retry-max-attempts"))
+	require.NotNil(t, cmd.Flag("retry-max-elapsed"))
+	require.NotNil(t, cmd.Flag("retry-base-delay"))
+	require.NotNil(t, cmd.Flag("retry-max-delay"))
+	require.NotNil(t, cmd.Flag("retry-jitter"))
+	require.NotNil(t, cmd.Flag("table-wait-max-elapsed"))
+	require.NotNil(t, cmd.Flag("session-duration"))
+	require.NotNil(t, cmd.Flag("debug"))
+}
+
+func TestSetupDependencies(t *testing.T) {
+	expectedConfig := dynamodbcopy.NewConfig(0, 0, 1, 1)
+
+	cmd := &cobra.Command{}
+
+	bindFlags(cmd.Flags())
+
+	deps, err := setupDependencies(cmd, []string{"table"}, log.New(os.Stdout, "", log.LstdFlags))
+
+	require.Nil(t, err)
+	require.NotNil(t, deps.Deleter)
+	require.NotNil(t, deps.Provisioner)
+	require.NotNil(t, deps.Guard)
+	require.Nil(t, deps.Backup)
+
+	assert.Equal(t, expectedConfig, deps.Config)
+
+	dir, err := ioutil.TempDir("", "deleteitems")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	require.Nil(t, cmd.Flags().Set("backup", filepath.Join(dir, "backup.json")))
+
+	deps, err = setupDependencies(cmd, []string{"table"}, log.New(os.Stdout, "", log.LstdFlags))
+
+	require.Nil(t, err)
+	require.NotNil(t, deps.Backup)
+	require.Nil(t, deps.Backup.Close())
+
+	_, err = os.Stat(filepath.Join(dir, "backup.json"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestRunBackup(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "deleteitems")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "backup.json")
+	require.Nil(t, ioutil.WriteFile(path, []byte("previous backup\n"), 0644))
+
+	// a refused delete keeps the existing backup file
+	guardMock := &mocks.Guard{}
+	guardMock.On("Check").Return(dynamodbcopy.ErrNotConfirmed).Once()
+
+	deps := dependencies{
+		Deleter:     &mocks.ItemDeleter{},
+		Provisioner: &mocks.Provisioner{},
+		Guard:       guardMock,
+		Backup:      &backupFile{path: path},
+		Logger:      log.New(ioutil.Discard, "", log.LstdFlags),
+	}
+
+	require.NotNil(t, run(deps))
+	require.Nil(t, deps.Backup.Close())
+
+	content, err := ioutil.ReadFile(path)
+	require.Nil(t, err)
+	assert.Equal(t, "previous backup\n", string(content))
+
+	// a dry run creates the backup file right away
+	deleterMock := &mocks.ItemDeleter{}
+	deleterMock.On("Delete", 1, 1).Return(int64(0), nil).Once()
+
+	deps.Deleter = deleterMock
+	deps.Backup = &backupFile{path: path}
+	deps.Config = dynamodbcopy.NewConfig(0, 0, 1, 1)
+	deps.DryRun = true
+
+	require.Nil(t, run(deps))
+	require.Nil(t, deps.Backup.Close())
+
+	content, err = ioutil.ReadFile(path)
+	require.Nil(t, err)
+	assert.Empty(t, content)
+
+	guardMock.AssertExpectations(t)
+	deleterMock.AssertExpectations(t)
+}
+
+func TestSetupDependenciesInvalidFlags(t *testing.T) {
+	for _, flag := range []string{"decrease-policy", "names", "values"} {
+		cmd := &cobra.Command{}
+
+		bindFlags(cmd.Flags())
+		require.Nil(t, cmd.Flags().Set(flag, "invalid"))
+
+		_, err := setupDependencies(cmd, []string{"table"}, log.New(os.Stdout, "", log.LstdFlags))
+
+		require.NotNil(t, err, flag)
+	}
+}
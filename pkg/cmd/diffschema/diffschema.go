@@ -0,0 +1,119 @@
+package diffschema
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"github.com/uniplaces/dynamodbcopy"
+	"github.com/uniplaces/dynamodbcopy/pkg/cmd/clientflags"
+)
+
+const (
+	cmdName          = "diff-schema"
+	shortDescription = "Compares the schemas and settings of two dynamoDB tables"
+)
+
+const (
+	srcTableKey   = "source-table"
+	trgTableKey   = "target-table"
+	srcRoleArnKey = "source-role-arn"
+	trgRoleArnKey = "target-role-arn"
+	debugKey      = "debug"
+)
+
+// New creates a new instance of the diff-schema command
+func New(logger dynamodbcopy.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("%s <source-table> <target-table>", cmdName),
+		Short: shortDescription,
+		Args:  cobra.ExactArgs(2),
+		RunE:  runHandler(logger),
+	}
+
+	bindFlags(cmd.Flags())
+
+	return cmd
+}
+
+func bindFlags(flagSet *pflag.FlagSet) {
+	flagSet.StringP(srcRoleArnKey, "s", "", "role arn that allows to describe the source table")
+	flagSet.StringP(trgRoleArnKey, "t", "", "role arn that allows to describe the target table")
+	clientflags.BindFlags(flagSet)
+	flagSet.BoolP(debugKey, "d", false, "enable debug logs")
+}
+
+func runHandler(logger dynamodbcopy.Logger) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		deps, err := setupDependencies(cmd, args, logger)
+		if err != nil {
+			return handleError("error setting up dependencies", err)
+		}
+
+		return run(deps)
+	}
+}
+
+func run(deps dependencies) error {
+	diff, err := deps.Differ.Diff()
+	if err != nil {
+		return handleError("error comparing tables", err)
+	}
+	diff.Print(deps.Logger)
+
+	if incompatibilities := diff.Incompatibilities(); incompatibilities > 0 {
+		return handleError("incompatible tables", fmt.Errorf("%d incompatible differences", incompatibilities))
+	}
+
+	return nil
+}
+
+func handleError(msg string, err error) error {
+	return fmt.Errorf("[%s] %s: %s", cmdName, msg, err)
+}
+
+type dependencies struct {
+	Differ dynamodbcopy.SchemaDiffer
+	Logger dynamodbcopy.Logger
+}
+
+func setupDependencies(cmd *cobra.Command, args []string, logger dynamodbcopy.Logger) (dependencies, error) {
+	config := viper.New()
+
+	config.SetDefault(srcTableKey, args[0])
+	config.SetDefault(trgTableKey, args[1])
+
+	if err := config.BindPFlags(cmd.Flags()); err != nil {
+		return dependencies{}, err
+	}
+
+	serviceOptions, err := clientflags.ServiceOptions(config)
+	if err != nil {
+		return dependencies{}, err
+	}
+
+	debugLogger := dynamodbcopy.NewDebugLogger(
+		logger,
+		config.GetBool(debugKey),
+	)
+	srcTableService := dynamodbcopy.NewDynamoDBService(
+		config.GetString(srcTableKey),
+		clientflags.NewDynamoClient(config, config.GetString(srcRoleArnKey), logger),
+		dynamodbcopy.Sleep,
+		debugLogger,
+		serviceOptions...,
+	)
+	trgTableService := dynamodbcopy.NewDynamoDBService(
+		config.GetString(trgTableKey),
+		clientflags.NewDynamoClient(config, config.GetString(trgRoleArnKey), logger),
+		dynamodbcopy.Sleep,
+		debugLogger,
+		serviceOptions...,
+	)
+
+	return dependencies{
+		Differ: dynamodbcopy.NewSchemaDiffer(srcTableService, trgTableService),
+		Logger: logger,
+	}, nil
+}
@@ -0,0 +1,106 @@
+package diffschema
+
+import (
+	"errors"
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+	"github.com/uniplaces/dynamodbcopy"
+	"github.com/uniplaces/dynamodbcopy/mocks"
+)
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	expectedError := errors.New("diff error")
+
+	testCases := []struct {
+		subTestName string
+		mocker      func(differ *mocks.SchemaDiffer)
+		expectError bool
+	}{
+		{
+			"DiffError",
+			func(differ *mocks.SchemaDiffer) {
+				differ.On("Diff").Return(nil, expectedError).Once()
+			},
+			true,
+		},
+		{
+			"Incompatible",
+			func(differ *mocks.SchemaDiffer) {
+				diff := dynamodbcopy.SchemaDiff{{Field: "key schema", Source: "a", Target: "b", Incompatible: true}}
+				differ.On("Diff").Return(diff, nil).Once()
+			},
+			true,
+		},
+		{
+			"Cosmetic",
+			func(differ *mocks.SchemaDiffer) {
+				diff := dynamodbcopy.SchemaDiff{{Field: "tags", Source: "a=1", Target: "<none>"}}
+				differ.On("Diff").Return(diff, nil).Once()
+			},
+			false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.subTestName,
+			func(st *testing.T) {
+				differMock := &mocks.SchemaDiffer{}
+
+				testCase.mocker(differMock)
+
+				deps := dependencies{
+					Differ: differMock,
+					Logger: log.New(ioutil.Discard, "", log.LstdFlags),
+				}
+
+				err := run(deps)
+
+				if testCase.expectError {
+					require.NotNil(st, err)
+				} else {
+					require.Nil(st, err)
+				}
+
+				differMock.AssertExpectations(st)
+			},
+		)
+	}
+}
+
+func TestBindFlags(t *testing.T) {
+	t.Parallel()
+
+	cmd := &cobra.Command{}
+
+	bindFlags(cmd.Flags())
+
+	require.NotNil(t, cmd.Flag("source-role-arn"))
+	require.NotNil(t, cmd.Flag("target-role-arn"))
+	require.NotNil(t, cmd.Flag("retry-max-attempts"))
+	require.NotNil(t, cmd.Flag("retry-max-elapsed"))
+	require.NotNil(t, cmd.Flag("retry-base-delay"))
+	require.NotNil(t, cmd.Flag("retry-max-delay"))
+	require.NotNil(t, cmd.Flag("retry-jitter"))
+	require.NotNil(t, cmd.Flag("table-wait-max-elapsed"))
+	require.NotNil(t, cmd.Flag("session-duration"))
+	require.NotNil(t, cmd.Flag("debug"))
+}
+
+func TestSetupDependencies(t *testing.T) {
+	cmd := &cobra.Command{}
+
+	bindFlags(cmd.Flags())
+
+	deps, err := setupDependencies(cmd, []string{"src", "trg"}, log.New(os.Stdout, "", log.LstdFlags))
+
+	require.Nil(t, err)
+	require.NotNil(t, deps.Differ)
+}
@@ -1,24 +1,97 @@
 package cmd
 
 import (
-	"log"
+	"io"
 	"os"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/uniplaces/dynamodbcopy"
+	"github.com/uniplaces/dynamodbcopy/pkg/cmd/copysettings"
 	"github.com/uniplaces/dynamodbcopy/pkg/cmd/copytable"
+	"github.com/uniplaces/dynamodbcopy/pkg/cmd/count"
+	"github.com/uniplaces/dynamodbcopy/pkg/cmd/deleteitems"
+	"github.com/uniplaces/dynamodbcopy/pkg/cmd/diffschema"
+	"github.com/uniplaces/dynamodbcopy/pkg/cmd/truncate"
 )
 
 const cmdName = "dynamodbcopy"
 
+const (
+	logFormatKey = "log-format"
+	logLevelKey  = "log-level"
+	debugKey     = "debug"
+)
+
 // New creates the root dynamodbcopy command
 func New() *cobra.Command {
+	logger := newLogger(os.Stdout)
+
 	cmd := &cobra.Command{
 		Use: cmdName,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			return logger.setup(cmd.Flags())
+		},
 	}
 
+	cmd.PersistentFlags().String(logFormatKey, string(dynamodbcopy.LogFormatText), "log format: text or json")
+	cmd.PersistentFlags().String(
+		logLevelKey,
+		dynamodbcopy.LevelInfo.String(),
+		"minimum level of the logs: debug, info, warn or error (the debug flag sets it to debug)",
+	)
+
 	cmd.AddCommand(
-		copytable.New(log.New(os.Stdout, "", log.LstdFlags)),
+		copytable.New(logger),
+		truncate.New(logger),
+		deleteitems.New(logger),
+		count.New(logger),
+		diffschema.New(logger),
+		copysettings.New(logger),
 	)
 
 	return cmd
 }
+
+// logger is the LeveledLogger shared by the commands, set up once the flags of the executed command are parsed
+type logger struct {
+	dynamodbcopy.LeveledLogger
+	out io.Writer
+}
+
+func newLogger(out io.Writer) *logger {
+	return &logger{
+		LeveledLogger: dynamodbcopy.NewLeveledLogger(out, dynamodbcopy.LogFormatText, dynamodbcopy.LevelInfo),
+		out:           out,
+	}
+}
+
+func (l *logger) setup(flagSet *pflag.FlagSet) error {
+	format, err := flagSet.GetString(logFormatKey)
+	if err != nil {
+		return err
+	}
+
+	logFormat, err := dynamodbcopy.ParseLogFormat(format)
+	if err != nil {
+		return err
+	}
+
+	level, err := flagSet.GetString(logLevelKey)
+	if err != nil {
+		return err
+	}
+
+	logLevel, err := dynamodbcopy.ParseLevel(level)
+	if err != nil {
+		return err
+	}
+
+	if debug, err := flagSet.GetBool(debugKey); err == nil && debug {
+		logLevel = dynamodbcopy.LevelDebug
+	}
+
+	l.LeveledLogger = dynamodbcopy.NewLeveledLogger(l.out, logFormat, logLevel)
+
+	return nil
+}
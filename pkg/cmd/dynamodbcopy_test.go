@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uniplaces/dynamodbcopy"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	cmd := New()
+
+	require.NotNil(t, cmd.PersistentFlags().Lookup("log-format"))
+	require.NotNil(t, cmd.PersistentFlags().Lookup("log-level"))
+	assert.Len(t, cmd.Commands(), 6)
+}
+
+func TestLoggerSetup(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		subTestName string
+		flags       map[string]string
+		expectError bool
+		expected    string
+	}{
+		{"InvalidFormat", map[string]string{"log-format": "invalid"}, true, ""},
+		{"InvalidLevel", map[string]string{"log-level": "invalid"}, true, ""},
+		{"Level", map[string]string{"log-level": "warn"}, false, ""},
+		{"JSON", map[string]string{"log-format": "json"}, false, `"msg":"message"`},
+		{"Debug", map[string]string{"log-level": "error", "debug": "true"}, false, "DEBUG message"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.subTestName,
+			func(st *testing.T) {
+				out := &bytes.Buffer{}
+				logger := newLogger(out)
+
+				cmd := &cobra.Command{}
+				cmd.Flags().String(logFormatKey, "text", "")
+				cmd.Flags().String(logLevelKey, "info", "")
+				cmd.Flags().Bool(debugKey, false, "")
+				for flag, value := range testCase.flags {
+					require.Nil(st, cmd.Flags().Set(flag, value))
+				}
+
+				err := logger.setup(cmd.Flags())
+				if testCase.expectError {
+					require.NotNil(st, err)
+
+					return
+				}
+				require.Nil(st, err)
+
+				logger.Printf("message")
+				logger.Log(dynamodbcopy.LevelDebug, "message", nil)
+
+				if testCase.expected == "" {
+					assert.Empty(st, out.String())
+				} else {
+					assert.Contains(st, out.String(), testCase.expected)
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,192 @@
+package truncate
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"github.com/uniplaces/dynamodbcopy"
+	"github.com/uniplaces/dynamodbcopy/pkg/cmd/clientflags"
+)
+
+const (
+	cmdName          = "truncate"
+	shortDescription = "Deletes all the dynamoDB records of a table"
+)
+
+const (
+	tableKey         = "table"
+	roleArnKey       = "role-arn"
+	readCapacityKey  = "read-capacity"
+	writeCapacityKey = "write-capacity"
+	readerCountKey   = "reader-count"
+	writerCountKey   = "writer-count"
+	autoScalingKey   = "auto-scaling"
+	decreaseKey      = "decrease-policy"
+	yesKey           = "yes"
+	debugKey         = "debug"
+)
+
+// New creates a new instance of the truncate command
+func New(logger dynamodbcopy.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("%s <table>", cmdName),
+		Short: shortDescription,
+		Args:  cobra.ExactArgs(1),
+		RunE:  runHandler(logger),
+	}
+
+	bindFlags(cmd.Flags())
+
+	return cmd
+}
+
+func bindFlags(flagSet *pflag.FlagSet) {
+	flagSet.String(roleArnKey, "", "role arn that allows to read from and delete from the table")
+	flagSet.Int(readCapacityKey, 0, "read provisioning capacity to set on the table")
+	flagSet.Int(writeCapacityKey, 0, "write provisioning capacity to set on the table")
+	flagSet.IntP(readerCountKey, "r", 1, "number of read workers to use")
+	flagSet.IntP(writerCountKey, "w", 1, "number of delete workers to use")
+	flagSet.Bool(
+		autoScalingKey,
+		false,
+		"raise the min capacity of the table auto scaling targets during the truncate, restoring them afterwards "+
+			"(requires the application-autoscaling permissions)",
+	)
+	flagSet.String(
+		decreaseKey,
+		string(dynamodbcopy.DecreaseWarn),
+		"how to handle raising the capacity of a table already decreased too many times today to be restored: "+
+			"warn (raise anyway), skip (keep the current capacity) or fail",
+	)
+	flagSet.BoolP(yesKey, "y", false, "skip the interactive confirmation of the truncate")
+	clientflags.BindFlags(flagSet)
+	flagSet.BoolP(debugKey, "d", false, "enable debug logs")
+}
+
+func runHandler(logger dynamodbcopy.Logger) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		deps, err := setupDependencies(cmd, args, logger)
+		if err != nil {
+			return handleError("error setting up dependencies", err)
+		}
+
+		return run(deps)
+	}
+}
+
+func run(deps dependencies) error {
+	if err := deps.Guard.Check(); err != nil {
+		return handleError("refusing to truncate", err)
+	}
+
+	initialProvisioning, err := deps.Provisioner.Fetch()
+	if err != nil {
+		return handleError("error fetching initial provisioning", err)
+	}
+
+	if _, err := deps.Provisioner.Update(deps.Config.TableProvisioning(initialProvisioning)); err != nil {
+		return handleError("error setting up provisioning before truncate", err)
+	}
+
+	err = deps.Truncater.Truncate(deps.Config.Workers())
+	deps.Logger.Printf("deleted %d items", deps.Report.Deleted())
+	if err != nil {
+		truncateErr := handleError("error truncating table", err)
+		if _, provisionErr := deps.Provisioner.Update(initialProvisioning); provisionErr != nil {
+			return handleError(truncateErr.Error(), provisionErr)
+		}
+
+		return truncateErr
+	}
+
+	if _, err := deps.Provisioner.Update(initialProvisioning); err != nil {
+		return handleError("error restoring initial provisioning", err)
+	}
+
+	return nil
+}
+
+func handleError(msg string, err error) error {
+	return fmt.Errorf("[%s] %s: %s", cmdName, msg, err)
+}
+
+type dependencies struct {
+	Truncater   dynamodbcopy.Truncater
+	Provisioner dynamodbcopy.Provisioner
+	Guard       dynamodbcopy.Guard
+	Config      dynamodbcopy.Config
+	Report      *dynamodbcopy.WriteReport
+	Logger      dynamodbcopy.Logger
+}
+
+func setupDependencies(cmd *cobra.Command, args []string, logger dynamodbcopy.Logger) (dependencies, error) {
+	config := viper.New()
+
+	config.SetDefault(tableKey, args[0])
+
+	if err := config.BindPFlags(cmd.Flags()); err != nil {
+		return dependencies{}, err
+	}
+
+	decreasePolicy, err := dynamodbcopy.ParseDecreasePolicy(config.GetString(decreaseKey))
+	if err != nil {
+		return dependencies{}, err
+	}
+
+	serviceOptions, err := clientflags.ServiceOptions(config)
+	if err != nil {
+		return dependencies{}, err
+	}
+
+	debugLogger := dynamodbcopy.NewDebugLogger(
+		logger,
+		config.GetBool(debugKey),
+	)
+	tableService := dynamodbcopy.NewDynamoDBService(
+		config.GetString(tableKey),
+		clientflags.NewDynamoClient(config, config.GetString(roleArnKey), logger),
+		dynamodbcopy.Sleep,
+		debugLogger,
+		serviceOptions...,
+	)
+
+	// the table is both scanned and deleted from, so it's provisioned once as the source table
+	provisioner := dynamodbcopy.NewTableProvisioner(tableService, decreasePolicy, debugLogger)
+	if config.GetBool(autoScalingKey) {
+		scalingService := dynamodbcopy.NewAutoScalingService(
+			config.GetString(tableKey),
+			dynamodbcopy.NewAutoScalingClient(config.GetString(roleArnKey)),
+			debugLogger,
+		)
+		provisioner = dynamodbcopy.NewAutoScalingProvisioner(provisioner, scalingService, nil, debugLogger)
+	}
+
+	var confirm dynamodbcopy.Confirmer
+	if !config.GetBool(yesKey) {
+		confirm = dynamodbcopy.NewConfirmer(os.Stdin, os.Stderr)
+	}
+
+	report := dynamodbcopy.NewWriteReport()
+
+	return dependencies{
+		Truncater: dynamodbcopy.NewTruncater(
+			tableService,
+			dynamodbcopy.NewCopierChan(config.GetInt(writerCountKey)),
+			report,
+			debugLogger,
+		),
+		Provisioner: provisioner,
+		Guard:       dynamodbcopy.NewTableGuard(tableService, "delete all items from", confirm),
+		Config: dynamodbcopy.NewConfig(
+			config.GetInt(readCapacityKey),
+			config.GetInt(writeCapacityKey),
+			config.GetInt(readerCountKey),
+			config.GetInt(writerCountKey),
+		),
+		Report: report,
+		Logger: logger,
+	}, nil
+}
@@ -0,0 +1,168 @@
+package truncate
+
+import (
+	"errors"
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uniplaces/dynamodbcopy"
+	"github.com/uniplaces/dynamodbcopy/mocks"
+)
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	expectedError := errors.New("truncate error")
+	defaultConfig := dynamodbcopy.NewConfig(5, 5, 2, 3)
+	defaultProvision := dynamodbcopy.Provisioning{}
+
+	testCases := []struct {
+		subTestName string
+		mocker      func(truncater *mocks.Truncater, provisioner *mocks.Provisioner, guard *mocks.Guard)
+		expectError bool
+	}{
+		{
+			"GuardError",
+			func(truncater *mocks.Truncater, provisioner *mocks.Provisioner, guard *mocks.Guard) {
+				guard.On("Check").Return(dynamodbcopy.ErrNotConfirmed).Once()
+			},
+			true,
+		},
+		{
+			"FetchProvisioningError",
+			func(truncater *mocks.Truncater, provisioner *mocks.Provisioner, guard *mocks.Guard) {
+				guard.On("Check").Return(nil).Once()
+				provisioner.On("Fetch").Return(dynamodbcopy.Provisioning{}, expectedError).Once()
+			},
+			true,
+		},
+		{
+			"UpdateError",
+			func(truncater *mocks.Truncater, provisioner *mocks.Provisioner, guard *mocks.Guard) {
+				guard.On("Check").Return(nil).Once()
+				provisioner.On("Fetch").Return(defaultProvision, nil).Once()
+				provisioner.On("Update", defaultProvision).Return(defaultProvision, expectedError).Once()
+			},
+			true,
+		},
+		{
+			"TruncateError",
+			func(truncater *mocks.Truncater, provisioner *mocks.Provisioner, guard *mocks.Guard) {
+				guard.On("Check").Return(nil).Once()
+				provisioner.On("Fetch").Return(defaultProvision, nil).Once()
+				provisioner.On("Update", defaultProvision).Return(defaultProvision, nil).Twice()
+				truncater.On("Truncate", 2, 3).Return(expectedError).Once()
+			},
+			true,
+		},
+		{
+			"RestoreProvisioningError",
+			func(truncater *mocks.Truncater, provisioner *mocks.Provisioner, guard *mocks.Guard) {
+				guard.On("Check").Return(nil).Once()
+				provisioner.On("Fetch").Return(defaultProvision, nil).Once()
+				provisioner.On("Update", defaultProvision).Return(defaultProvision, nil).Once()
+				truncater.On("Truncate", 2, 3).Return(nil).Once()
+				provisioner.On("Update", defaultProvision).Return(defaultProvision, expectedError).Once()
+			},
+			true,
+		},
+		{
+			"Success",
+			func(truncater *mocks.Truncater, provisioner *mocks.Provisioner, guard *mocks.Guard) {
+				guard.On("Check").Return(nil).Once()
+				provisioner.On("Fetch").Return(defaultProvision, nil).Once()
+				provisioner.On("Update", defaultProvision).Return(defaultProvision, nil).Twice()
+				truncater.On("Truncate", 2, 3).Return(nil).Once()
+			},
+			false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.subTestName,
+			func(st *testing.T) {
+				truncaterMock := &mocks.Truncater{}
+				provisionerMock := &mocks.Provisioner{}
+				guardMock := &mocks.Guard{}
+
+				testCase.mocker(truncaterMock, provisionerMock, guardMock)
+
+				deps := dependencies{
+					Truncater:   truncaterMock,
+					Provisioner: provisionerMock,
+					Guard:       guardMock,
+					Config:      defaultConfig,
+					Report:      dynamodbcopy.NewWriteReport(),
+					Logger:      log.New(ioutil.Discard, "", log.LstdFlags),
+				}
+
+				err := run(deps)
+
+				if testCase.expectError {
+					require.NotNil(st, err)
+				} else {
+					require.Nil(st, err)
+				}
+
+				truncaterMock.AssertExpectations(st)
+				provisionerMock.AssertExpectations(st)
+				guardMock.AssertExpectations(st)
+			},
+		)
+	}
+}
+
+func TestBindFlags(t *testing.T) {
+	t.Parallel()
+
+	cmd := &cobra.Command{}
+
+	bindFlags(cmd.Flags())
+
+	require.NotNil(t, cmd.Flag("role-arn"))
+	require.NotNil(t, cmd.Flag("read-capacity"))
+	require.NotNil(t, cmd.Flag("write-capacity"))
+	require.NotNil(t, cmd.Flag("reader-count"))
+	require.NotNil(t, cmd.Flag("writer-count"))
+	require.NotNil(t, cmd.Flag("auto-scaling"))
+	require.NotNil(t, cmd.Flag("decrease-policy"))
+	require.NotNil(t, cmd.Flag("yes"))
+	require.NotNil(t, cmd.Flag("retry-max-attempts"))
+	require.NotNil(t, cmd.Flag("retry-max-elapsed"))
+	require.NotNil(t, cmd.Flag("retry-base-delay"))
+	require.NotNil(t, cmd.Flag("retry-max-delay"))
+	require.NotNil(t, cmd.Flag("retry-jitter"))
+	require.NotNil(t, cmd.Flag("table-wait-max-elapsed"))
+	require.NotNil(t, cmd.Flag("session-duration"))
+	require.NotNil(t, cmd.Flag("debug"))
+}
+
+func TestSetupDependencies(t *testing.T) {
+	expectedConfig := dynamodbcopy.NewConfig(0, 0, 1, 1)
+
+	cmd := &cobra.Command{}
+
+	bindFlags(cmd.Flags())
+
+	deps, err := setupDependencies(cmd, []string{"table"}, log.New(os.Stdout, "", log.LstdFlags))
+
+	require.Nil(t, err)
+	require.NotNil(t, deps.Truncater)
+	require.NotNil(t, deps.Provisioner)
+	require.NotNil(t, deps.Guard)
+	require.NotNil(t, deps.Report)
+
+	assert.Equal(t, expectedConfig, deps.Config)
+
+	require.Nil(t, cmd.Flags().Set("decrease-policy", "invalid"))
+
+	_, err = setupDependencies(cmd, []string{"table"}, log.New(os.Stdout, "", log.LstdFlags))
+
+	require.NotNil(t, err)
+}
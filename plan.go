@@ -0,0 +1,235 @@
+package dynamodbcopy
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+// Estimates use DynamoDB's capacity unit sizes and us-east-1 prices (in USD)
+const (
+	readUnitBytes  = 4096
+	writeUnitBytes = 1024
+
+	onDemandReadUnitPrice     = 0.25 / 1000000
+	onDemandWriteUnitPrice    = 1.25 / 1000000
+	provisionedReadHourPrice  = 0.00013
+	provisionedWriteHourPrice = 0.00065
+)
+
+// Planner is the interface that allows you to plan a copy without performing any change to the tables
+type Planner interface {
+	Plan(current Provisioning) (Plan, error)
+}
+
+type planService struct {
+	srcTable DynamoDBService
+	trgTable DynamoDBService
+	config   Config
+}
+
+// NewPlanner returns a new Planner that plans a copy between the given tables with the given Config
+func NewPlanner(srcTableService, trgTableService DynamoDBService, config Config) Planner {
+	return planService{
+		srcTable: srcTableService,
+		trgTable: trgTableService,
+		config:   config,
+	}
+}
+
+// Plan describes what a copy would do, with estimates based on DynamoDB's approximate item count and table size,
+// which are updated roughly every six hours
+type Plan struct {
+	SourceTable  string
+	TargetTable  string
+	Current      Provisioning
+	Update       Provisioning
+	ItemCount    int64
+	SizeBytes    int64
+	ReadUnits    float64
+	WriteUnits   float64
+	Duration     time.Duration
+	CostEstimate float64
+}
+
+// Plan describes both tables, validating that their key schemas are compatible, and estimates the provisioning
+// changes, capacity consumed, duration and cost of copying the source table into the target table.
+// The capacity consumed by the writes takes into account every global secondary index of the target table.
+// When the copy isn't limited by any capacity (on-demand tables without capacity units) the duration is unknown (0)
+func (service planService) Plan(current Provisioning) (Plan, error) {
+	srcDescription, err := service.srcTable.DescribeTable()
+	if err != nil {
+		return Plan{}, err
+	}
+
+	trgDescription, err := service.trgTable.DescribeTable()
+	if err != nil {
+		return Plan{}, err
+	}
+
+	if err := checkKeySchemas(srcDescription, trgDescription); err != nil {
+		return Plan{}, err
+	}
+
+	plan := Plan{
+		SourceTable: aws.StringValue(srcDescription.TableName),
+		TargetTable: aws.StringValue(trgDescription.TableName),
+		Current:     current,
+		Update:      service.config.Provisioning(current),
+		ItemCount:   aws.Int64Value(srcDescription.ItemCount),
+		SizeBytes:   aws.Int64Value(srcDescription.TableSizeBytes),
+	}
+
+	// scans are eventually consistent, consuming half a read unit per 4KB read
+	plan.ReadUnits = math.Ceil(float64(plan.SizeBytes)/readUnitBytes) / 2
+	if plan.ItemCount > 0 {
+		itemUnits := math.Ceil(float64(plan.SizeBytes) / float64(plan.ItemCount) / writeUnitBytes)
+		plan.WriteUnits = float64(plan.ItemCount) * math.Max(itemUnits, 1) *
+			float64(1+len(trgDescription.GlobalSecondaryIndexes))
+	}
+
+	readRate, writeRate := service.rates(plan.Update)
+	if readRate > 0 && writeRate > 0 {
+		seconds := math.Max(plan.ReadUnits/float64(readRate), plan.WriteUnits/float64(writeRate))
+		plan.Duration = time.Duration(math.Ceil(seconds)) * time.Second
+	}
+
+	plan.CostEstimate = plan.cost()
+
+	return plan, nil
+}
+
+// rates returns the read and write units per second the copy is limited to with the given Provisioning
+func (service planService) rates(provisioning Provisioning) (int64, int64) {
+	readRate, writeRate := service.config.RateLimits(provisioning)
+	if provisioning.Source != nil {
+		readRate = provisioning.Source.Read
+	}
+
+	if provisioning.Target != nil {
+		writeRate = provisioning.Target.Write
+	}
+
+	return readRate, writeRate
+}
+
+func (p Plan) cost() float64 {
+	hours := p.Duration.Hours()
+
+	var cost float64
+	if p.Update.Source != nil {
+		cost += float64(p.Update.Source.Read) * hours * provisionedReadHourPrice
+	} else {
+		cost += p.ReadUnits * onDemandReadUnitPrice
+	}
+
+	if p.Update.Target != nil {
+		writeUnits := p.Update.Target.Write
+		for _, capacity := range p.Update.TargetIndexes {
+			writeUnits += capacity.Write
+		}
+		cost += float64(writeUnits) * hours * provisionedWriteHourPrice
+	} else {
+		cost += p.WriteUnits * onDemandWriteUnitPrice
+	}
+
+	return cost
+}
+
+// Print logs the Plan in a human readable format
+func (p Plan) Print(logger Logger) {
+	logger.Printf("source table %s: ~%d items, ~%d bytes", p.SourceTable, p.ItemCount, p.SizeBytes)
+	logger.Printf("target table %s", p.TargetTable)
+
+	printProvisioningChange(logger, "source", p.Current.source(), p.Update.source())
+	printProvisioningChange(logger, "target", p.Current.target(), p.Update.target())
+
+	logger.Printf("estimated consumption: %.0f read units, %.0f write units", p.ReadUnits, p.WriteUnits)
+	if p.Duration > 0 {
+		logger.Printf("estimated duration: %s", p.Duration)
+	} else {
+		logger.Printf("estimated duration: unknown (the copy is not limited by any capacity)")
+	}
+	logger.Printf("estimated cost: $%.2f", p.CostEstimate)
+}
+
+func printProvisioningChange(logger Logger, label string, current, update tableProvisioning) {
+	if current.billingMode != update.billingMode || needsProvisioningUpdate(current.capacity, update.capacity) {
+		logger.Printf(
+			"%s table provisioning: %s -> %s",
+			label,
+			formatCapacity(current.capacity),
+			formatCapacity(update.capacity),
+		)
+	} else {
+		logger.Printf("%s table provisioning: unchanged (%s)", label, formatCapacity(current.capacity))
+	}
+
+	for _, index := range sortedIndexNames(update.indexes) {
+		if capacity := current.indexes[index]; needsProvisioningUpdate(capacity, update.indexes[index]) {
+			logger.Printf(
+				"%s index %s provisioning: %s -> %s",
+				label,
+				index,
+				formatCapacity(capacity),
+				formatCapacity(update.indexes[index]),
+			)
+		}
+	}
+}
+
+func formatCapacity(capacity *Capacity) string {
+	if capacity == nil {
+		return "on-demand"
+	}
+
+	return fmt.Sprintf("r: %d w: %d", capacity.Read, capacity.Write)
+}
+
+// checkKeySchemas validates that items from the source table can be written into the target table,
+// requiring both tables to have the same key attributes, with the same types
+func checkKeySchemas(src, trg *dynamodb.TableDescription) error {
+	srcKeys, trgKeys := keyAttributes(src), keyAttributes(trg)
+	if len(srcKeys) != len(trgKeys) {
+		return fmt.Errorf("incompatible key schemas: source %v, target %v", srcKeys, trgKeys)
+	}
+
+	for i := range srcKeys {
+		if srcKeys[i] != trgKeys[i] {
+			return fmt.Errorf("incompatible key schemas: source %v, target %v", srcKeys, trgKeys)
+		}
+	}
+
+	return nil
+}
+
+// keyAttributes returns the key attributes of a table, formatted as <key type> <name> (<attribute type>)
+func keyAttributes(description *dynamodb.TableDescription) []string {
+	return keySchemaAttributes(description.KeySchema, attributeTypes(description))
+}
+
+// keySchemaAttributes formats a key schema (of a table or index) like keyAttributes
+func keySchemaAttributes(schema []*dynamodb.KeySchemaElement, types map[string]string) []string {
+	keys := make([]string, len(schema))
+	for i, key := range schema {
+		name := aws.StringValue(key.AttributeName)
+		keys[i] = fmt.Sprintf("%s %s (%s)", aws.StringValue(key.KeyType), name, types[name])
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// attributeTypes returns the types of the attributes defined in a table, by name
+func attributeTypes(description *dynamodb.TableDescription) map[string]string {
+	types := make(map[string]string, len(description.AttributeDefinitions))
+	for _, definition := range description.AttributeDefinitions {
+		types[aws.StringValue(definition.AttributeName)] = aws.StringValue(definition.AttributeType)
+	}
+
+	return types
+}
@@ -0,0 +1,143 @@
+package dynamodbcopy_test
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/uniplaces/dynamodbcopy"
+	"github.com/uniplaces/dynamodbcopy/mocks"
+)
+
+func TestPlan(t *testing.T) {
+	t.Parallel()
+
+	srcDescription := buildKeyedTableDescription(srcTableName, "id", dynamodb.ScalarAttributeTypeS)
+	srcDescription.ItemCount = aws.Int64(1000)
+	srcDescription.TableSizeBytes = aws.Int64(2048000)
+
+	trgDescription := buildKeyedTableDescription(trgTableName, "id", dynamodb.ScalarAttributeTypeS)
+	trgDescription.GlobalSecondaryIndexes = []*dynamodb.GlobalSecondaryIndexDescription{
+		buildIndexDescription("index", 5, 5),
+	}
+
+	expectedError := errors.New("describe error")
+
+	testCases := []struct {
+		subTestName   string
+		mocker        func(srcService, trgService *mocks.DynamoDBService)
+		expectedPlan  dynamodbcopy.Plan
+		expectedError bool
+	}{
+		{
+			"SrcDescribeError",
+			func(srcService, trgService *mocks.DynamoDBService) {
+				srcService.On("DescribeTable").Return(nil, expectedError).Once()
+			},
+			dynamodbcopy.Plan{},
+			true,
+		},
+		{
+			"TrgDescribeError",
+			func(srcService, trgService *mocks.DynamoDBService) {
+				srcService.On("DescribeTable").Return(&srcDescription, nil).Once()
+				trgService.On("DescribeTable").Return(nil, expectedError).Once()
+			},
+			dynamodbcopy.Plan{},
+			true,
+		},
+		{
+			"IncompatibleKeySchemas",
+			func(srcService, trgService *mocks.DynamoDBService) {
+				description := buildKeyedTableDescription(trgTableName, "id", dynamodb.ScalarAttributeTypeN)
+
+				srcService.On("DescribeTable").Return(&srcDescription, nil).Once()
+				trgService.On("DescribeTable").Return(&description, nil).Once()
+			},
+			dynamodbcopy.Plan{},
+			true,
+		},
+		{
+			"Success",
+			func(srcService, trgService *mocks.DynamoDBService) {
+				srcService.On("DescribeTable").Return(&srcDescription, nil).Once()
+				trgService.On("DescribeTable").Return(&trgDescription, nil).Once()
+			},
+			dynamodbcopy.Plan{
+				SourceTable:  srcTableName,
+				TargetTable:  trgTableName,
+				Current:      buildProvision(5, 5),
+				Update:       buildProvision(10, 100),
+				ItemCount:    1000,
+				SizeBytes:    2048000,
+				ReadUnits:    250,
+				WriteUnits:   4000,
+				Duration:     40 * time.Second,
+				CostEstimate: (10*0.00013 + 100*0.00065) * 40 / 3600,
+			},
+			false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.subTestName,
+			func(st *testing.T) {
+				srcService := &mocks.DynamoDBService{}
+				trgService := &mocks.DynamoDBService{}
+
+				testCase.mocker(srcService, trgService)
+
+				planner := dynamodbcopy.NewPlanner(srcService, trgService, dynamodbcopy.NewConfig(10, 100, 1, 1))
+
+				plan, err := planner.Plan(buildProvision(5, 5))
+
+				assertExpectedError(st, testCase.expectedError, err)
+
+				assert.InDelta(st, testCase.expectedPlan.CostEstimate, plan.CostEstimate, 0.000001)
+				plan.CostEstimate = testCase.expectedPlan.CostEstimate
+				assert.Equal(st, testCase.expectedPlan, plan)
+
+				srcService.AssertExpectations(st)
+				trgService.AssertExpectations(st)
+			},
+		)
+	}
+}
+
+func TestPlanPrint(t *testing.T) {
+	t.Parallel()
+
+	plan := dynamodbcopy.Plan{
+		SourceTable: srcTableName,
+		TargetTable: trgTableName,
+		Current:     buildProvision(5, 5),
+		Update:      buildProvision(5, 100),
+	}
+
+	buffer := &bytes.Buffer{}
+	plan.Print(log.New(buffer, "", 0))
+
+	output := buffer.String()
+	assert.Contains(t, output, "source table provisioning: unchanged (r: 5 w: 5)")
+	assert.Contains(t, output, "target table provisioning: r: 5 w: 5 -> r: 5 w: 100")
+	assert.Contains(t, output, "estimated duration: unknown")
+	assert.Contains(t, output, "estimated cost: $0.00")
+}
+
+func buildKeyedTableDescription(table, key, attributeType string) dynamodb.TableDescription {
+	description := buildDefaultTableDescription(table)
+	description.KeySchema = []*dynamodb.KeySchemaElement{
+		{AttributeName: aws.String(key), KeyType: aws.String(dynamodb.KeyTypeHash)},
+	}
+	description.AttributeDefinitions = []*dynamodb.AttributeDefinition{
+		{AttributeName: aws.String(key), AttributeType: aws.String(attributeType)},
+	}
+
+	return description
+}
@@ -1,6 +1,49 @@
 package dynamodbcopy
 
-import "github.com/aws/aws-sdk-go/service/dynamodb"
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+const (
+	// billingModeSwitchInterval is the minimum time DynamoDB requires between switches of a table to on-demand
+	billingModeSwitchInterval = 24 * time.Hour
+	// freeDecreasesPerDay is the number of capacity decreases DynamoDB allows at any time of the (UTC) day.
+	// Further decreases are only allowed if no decrease happened in the last hour, so they aren't guaranteed
+	freeDecreasesPerDay = 4
+)
+
+// DecreasePolicy defines how the Provisioner handles raising the capacity of a table (or index) that can't be
+// guaranteed to be decreased back today, as DynamoDB limits the number of capacity decreases per day
+type DecreasePolicy string
+
+const (
+	// DecreaseWarn logs a warning and raises the capacity anyway
+	DecreaseWarn DecreasePolicy = "warn"
+	// DecreaseSkip logs a warning and keeps the current capacity
+	DecreaseSkip DecreasePolicy = "skip"
+	// DecreaseFail refuses to update the provisioning, returning an error
+	DecreaseFail DecreasePolicy = "fail"
+)
+
+// ParseDecreasePolicy returns the DecreasePolicy that matches the given value
+func ParseDecreasePolicy(value string) (DecreasePolicy, error) {
+	switch policy := DecreasePolicy(value); policy {
+	case DecreaseWarn, DecreaseSkip, DecreaseFail:
+		return policy, nil
+	default:
+		return "", fmt.Errorf(
+			"invalid decrease policy %q: must be one of %s, %s, %s",
+			value,
+			DecreaseWarn,
+			DecreaseSkip,
+			DecreaseFail,
+		)
+	}
+}
 
 // Provisioner is the interface that provides the methods to manipulate DynamoDB's provisioning values
 type Provisioner interface {
@@ -9,99 +52,446 @@ type Provisioner interface {
 }
 
 type provisioningService struct {
-	srcTable DynamoDBService
-	trgTable DynamoDBService
-	logger   Logger
+	srcTable       DynamoDBService
+	trgTable       DynamoDBService
+	decreasePolicy DecreasePolicy
+	logger         Logger
 }
 
-// NewProvisioner returns a new Provisioner to manipulate the source and target table provisioning values
-func NewProvisioner(srcTableService, trgTableService DynamoDBService, logger Logger) Provisioner {
+// NewProvisioner returns a new Provisioner to manipulate the source and target table provisioning values.
+// The given DecreasePolicy is applied when raising capacity that might not be restored today
+func NewProvisioner(
+	srcTableService,
+	trgTableService DynamoDBService,
+	decreasePolicy DecreasePolicy,
+	logger Logger,
+) Provisioner {
 	return provisioningService{
-		srcTable: srcTableService,
-		trgTable: trgTableService,
-		logger:   logger,
+		srcTable:       srcTableService,
+		trgTable:       trgTableService,
+		decreasePolicy: decreasePolicy,
+		logger:         logger,
+	}
+}
+
+// NewTableProvisioner returns a new Provisioner for commands that read from and write into a single table.
+// The table is provisioned as the source table of the Provisioning, while the target table values are left unset
+// and ignored, so the table is only updated once
+func NewTableProvisioner(tableService DynamoDBService, decreasePolicy DecreasePolicy, logger Logger) Provisioner {
+	return provisioningService{
+		srcTable:       tableService,
+		decreasePolicy: decreasePolicy,
+		logger:         logger,
 	}
 }
 
 // Fetch returns the current provisioning values for the source and target DynamoDB tables
 func (dc provisioningService) Fetch() (Provisioning, error) {
+	_, _, provisioning, err := dc.describe()
+
+	return provisioning, err
+}
+
+// describe describes the source and target tables, returning their descriptions and current provisioning.
+// Without a target table (NewTableProvisioner), the target description is nil and its provisioning is left unset
+func (dc provisioningService) describe() (*dynamodb.TableDescription, *dynamodb.TableDescription, Provisioning, error) {
 	srcDescription, err := dc.srcTable.DescribeTable()
 	if err != nil {
-		return Provisioning{}, err
+		return nil, nil, Provisioning{}, err
+	}
+
+	if dc.trgTable == nil {
+		provisioning := NewProvisioning(srcDescription, srcDescription)
+		provisioning.Target, provisioning.TargetIndexes, provisioning.TargetBillingMode = nil, nil, ""
+
+		return srcDescription, nil, provisioning, nil
 	}
 
 	trgDescription, err := dc.trgTable.DescribeTable()
 	if err != nil {
-		return Provisioning{}, err
+		return nil, nil, Provisioning{}, err
 	}
 
-	return NewProvisioning(srcDescription, trgDescription), nil
+	return srcDescription, trgDescription, NewProvisioning(srcDescription, trgDescription), nil
 }
 
 // Update will update the provisioning of the source and target table with the provided Provisioning value
 //
 // For each table, Update checks if the given provisioning value differs from the current provisioning value
-// on each table. If so, it will update each table accordingly.
+// on each table. If so, it will update each table accordingly. The same applies to each global secondary index
+// capacity of the tables.
+//
+// When the given billing mode of a table differs from its current one, the table is switched to that billing mode.
+// Switching an on-demand table to provisioned is refused if the table could not be switched back to on-demand
+// afterwards, as DynamoDB only allows a table to be switched to on-demand once every 24 hours.
+//
+// Before raising any capacity, Update checks if the table (or index) was already decreased too many times today to
+// guarantee that the capacity can be restored, applying the Provisioner's DecreasePolicy if so.
+// The returned Provisioning holds the capacities that were actually applied.
+// A Provisioner created with NewTableProvisioner ignores the target values of the given Provisioning.
 func (dc provisioningService) Update(provisioning Provisioning) (Provisioning, error) {
-	currentProvisioning, err := dc.Fetch()
+	srcDescription, trgDescription, currentProvisioning, err := dc.describe()
 	if err != nil {
 		return Provisioning{}, err
 	}
 
-	if needsProvisioningUpdate(currentProvisioning.Source, provisioning.Source) {
-		if err := dc.srcTable.UpdateCapacity(*provisioning.Source); err != nil {
-			return Provisioning{}, err
-		}
+	srcSwitch, err := billingModeSwitch(srcDescription, currentProvisioning.source(), provisioning.source())
+	if err != nil {
+		return Provisioning{}, err
+	}
 
-		dc.logger.Printf("updated source table r: %d w: %d", provisioning.Source.Read, provisioning.Source.Write)
+	if dc.trgTable == nil {
+		provisioning.Target, provisioning.TargetIndexes, provisioning.TargetBillingMode = nil, nil, ""
+	}
+
+	trgSwitch, err := billingModeSwitch(trgDescription, currentProvisioning.target(), provisioning.target())
+	if err != nil {
+		return Provisioning{}, err
+	}
+
+	provisioning.Source, provisioning.SourceIndexes, err = dc.checkDecreases(
+		"source",
+		srcDescription,
+		currentProvisioning.source(),
+		provisioning.source(),
+	)
+	if err != nil {
+		return Provisioning{}, err
 	}
 
-	if needsProvisioningUpdate(currentProvisioning.Target, provisioning.Target) {
-		if err := dc.trgTable.UpdateCapacity(*provisioning.Target); err != nil {
+	if dc.trgTable != nil {
+		provisioning.Target, provisioning.TargetIndexes, err = dc.checkDecreases(
+			"target",
+			trgDescription,
+			currentProvisioning.target(),
+			provisioning.target(),
+		)
+		if err != nil {
 			return Provisioning{}, err
 		}
+	}
+
+	err = dc.updateTable("source", dc.srcTable, srcSwitch, currentProvisioning.source(), provisioning.source())
+	if err != nil {
+		return Provisioning{}, err
+	}
 
-		dc.logger.Printf("updated target table r: %d w: %d", provisioning.Target.Read, provisioning.Target.Write)
+	if dc.trgTable == nil {
+		return provisioning, nil
+	}
+
+	err = dc.updateTable("target", dc.trgTable, trgSwitch, currentProvisioning.target(), provisioning.target())
+	if err != nil {
+		return Provisioning{}, err
 	}
 
 	return provisioning, nil
 }
 
+func (dc provisioningService) updateTable(
+	label string,
+	table DynamoDBService,
+	switchBillingMode bool,
+	current tableProvisioning,
+	update tableProvisioning,
+) error {
+	if switchBillingMode {
+		if err := table.UpdateBillingMode(update.capacity); err != nil {
+			return err
+		}
+
+		dc.logger.Printf("switched %s table to %s billing mode", label, update.billingMode)
+
+		return nil
+	}
+
+	var capacity *Capacity
+	if needsProvisioningUpdate(current.capacity, update.capacity) {
+		capacity = update.capacity
+	}
+
+	var indexes map[string]*Capacity
+	for index, indexCapacity := range update.indexes {
+		if !needsProvisioningUpdate(current.indexes[index], indexCapacity) {
+			continue
+		}
+
+		if indexes == nil {
+			indexes = make(map[string]*Capacity)
+		}
+		indexes[index] = indexCapacity
+	}
+
+	if capacity == nil && indexes == nil {
+		return nil
+	}
+
+	if err := table.UpdateProvisioning(capacity, indexes); err != nil {
+		return err
+	}
+
+	if capacity != nil {
+		dc.logger.Printf("updated %s table r: %d w: %d", label, capacity.Read, capacity.Write)
+	}
+
+	for _, index := range sortedIndexNames(indexes) {
+		dc.logger.Printf("updated %s table index %s r: %d w: %d", label, index, indexes[index].Read, indexes[index].Write)
+	}
+
+	return nil
+}
+
+// checkDecreases applies the DecreasePolicy to the table and index capacities of update that raise the current
+// capacity of a table or index without enough capacity decreases left today, returning the resulting capacities
+func (dc provisioningService) checkDecreases(
+	label string,
+	description *dynamodb.TableDescription,
+	current tableProvisioning,
+	update tableProvisioning,
+) (*Capacity, map[string]*Capacity, error) {
+	capacity, err := dc.checkCapacityDecreases(
+		fmt.Sprintf("%s table %s", label, *description.TableName),
+		description.ProvisionedThroughput,
+		current.capacity,
+		update.capacity,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	indexes := update.indexes
+	for _, index := range description.GlobalSecondaryIndexes {
+		name := *index.IndexName
+		indexCapacity, err := dc.checkCapacityDecreases(
+			fmt.Sprintf("%s table %s index %s", label, *description.TableName, name),
+			index.ProvisionedThroughput,
+			current.indexes[name],
+			update.indexes[name],
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if indexCapacity != update.indexes[name] {
+			indexes = copyIndexCapacities(indexes)
+			indexes[name] = indexCapacity
+		}
+	}
+
+	return capacity, indexes, nil
+}
+
+func (dc provisioningService) checkCapacityDecreases(
+	label string,
+	throughput *dynamodb.ProvisionedThroughputDescription,
+	current *Capacity,
+	update *Capacity,
+) (*Capacity, error) {
+	if !raisesCapacity(current, update) || throughput == nil || throughput.NumberOfDecreasesToday == nil {
+		return update, nil
+	}
+
+	decreases := *throughput.NumberOfDecreasesToday
+	if decreases < freeDecreasesPerDay {
+		return update, nil
+	}
+
+	switch dc.decreasePolicy {
+	case DecreaseFail:
+		return nil, fmt.Errorf(
+			"refusing to raise %s capacity: it was already decreased %d times today, so it might not be restored",
+			label,
+			decreases,
+		)
+	case DecreaseSkip:
+		logWith(
+			dc.logger,
+			LevelWarn,
+			Fields{"decreases_today": decreases},
+			"keeping %s capacity: it was already decreased %d times today, so it might not be restored",
+			label,
+			decreases,
+		)
+
+		return current, nil
+	default:
+		logWith(
+			dc.logger,
+			LevelWarn,
+			Fields{"decreases_today": decreases},
+			"raising %s capacity: it was already decreased %d times today, so it might not be restored",
+			label,
+			decreases,
+		)
+
+		return update, nil
+	}
+}
+
+func raisesCapacity(current, update *Capacity) bool {
+	return current != nil && update != nil && (update.Read > current.Read || update.Write > current.Write)
+}
+
+func copyIndexCapacities(indexes map[string]*Capacity) map[string]*Capacity {
+	copied := make(map[string]*Capacity, len(indexes))
+	for name, capacity := range indexes {
+		copied[name] = capacity
+	}
+
+	return copied
+}
+
 func needsProvisioningUpdate(c1, c2 *Capacity) bool {
 	return c1 != nil && c2 != nil && (c1.Read != c2.Read || c1.Write != c2.Write)
 }
 
+func sortedIndexNames(indexes map[string]*Capacity) []string {
+	names := make([]string, 0, len(indexes))
+	for name := range indexes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// billingModeSwitch returns true when the table needs to be switched to the update billing mode,
+// returning an error if the table described by description can't be switched
+func billingModeSwitch(description *dynamodb.TableDescription, current, update tableProvisioning) (bool, error) {
+	if update.billingMode == "" || current.billingMode == update.billingMode {
+		return false, nil
+	}
+
+	return true, checkBillingModeSwitch(description, update.billingMode)
+}
+
+// checkBillingModeSwitch validates that the table described by description can be switched to billingMode.
+// DynamoDB only allows a table to be switched to on-demand once every 24 hours, so a table that was recently switched
+// to on-demand can neither be switched back to on-demand nor to provisioned (as it couldn't be restored afterwards)
+func checkBillingModeSwitch(description *dynamodb.TableDescription, billingMode string) error {
+	summary := description.BillingModeSummary
+	if summary == nil || summary.LastUpdateToPayPerRequestDateTime == nil {
+		return nil
+	}
+
+	allowedAt := summary.LastUpdateToPayPerRequestDateTime.Add(billingModeSwitchInterval)
+	if time.Now().Before(allowedAt) {
+		return fmt.Errorf(
+			"unable to switch table %s to %s billing mode: it was switched to on-demand at %s and cannot be "+
+				"switched back before %s",
+			*description.TableName,
+			billingMode,
+			summary.LastUpdateToPayPerRequestDateTime.Format(time.RFC3339),
+			allowedAt.Format(time.RFC3339),
+		)
+	}
+
+	return nil
+}
+
 // Capacity abstracts the read and write units capacities values
 type Capacity struct {
 	Read  int64
 	Write int64
 }
 
-// Provisioning stores the provisioning capacities for the source and target tables
-// The Capacity for each table will be nil when the table's billing mode isn't BillingModeProvisioned
+// Provisioning stores the provisioning capacities and billing modes for the source and target tables
+// The Capacity for each table will be nil when the table's billing mode isn't BillingModeProvisioned.
+// The index capacities hold the Capacity of each global secondary index of a provisioned table, by index name.
+// An empty billing mode leaves the table's current billing mode untouched when updating.
+// The scaling values hold the auto scaling targets of each table, which are only managed by a Provisioner created
+// with NewAutoScalingProvisioner.
 type Provisioning struct {
-	Source *Capacity
-	Target *Capacity
+	Source            *Capacity
+	Target            *Capacity
+	SourceIndexes     map[string]*Capacity
+	TargetIndexes     map[string]*Capacity
+	SourceBillingMode string
+	TargetBillingMode string
+	SourceScaling     []ScalableTarget
+	TargetScaling     []ScalableTarget
+}
+
+type tableProvisioning struct {
+	capacity    *Capacity
+	indexes     map[string]*Capacity
+	billingMode string
+}
+
+func (p Provisioning) source() tableProvisioning {
+	return tableProvisioning{capacity: p.Source, indexes: p.SourceIndexes, billingMode: p.SourceBillingMode}
+}
+
+func (p Provisioning) target() tableProvisioning {
+	return tableProvisioning{capacity: p.Target, indexes: p.TargetIndexes, billingMode: p.TargetBillingMode}
+}
+
+// capacityUnits returns the capacity units of the table or index scaled by the given ScalableTarget
+func (t tableProvisioning) capacityUnits(target ScalableTarget) int64 {
+	capacity := t.capacity
+	if index := target.Index(); index != "" {
+		capacity = t.indexes[index]
+	}
+
+	if capacity == nil {
+		return 0
+	}
+
+	if target.IsRead() {
+		return capacity.Read
+	}
+
+	return capacity.Write
 }
 
 // NewProvisioning creates a new Provisioning based on the source and target tables dynamodb.TableDescription
-// It will only set capacity for each table if the is BillingModeProvisioned
+// It will only set capacity for each table (and its global secondary indexes) if its billing mode is
+// BillingModeProvisioned. Tables without a BillingModeSummary have always been provisioned, so they are treated as
+// BillingModeProvisioned
 func NewProvisioning(srcDescription, trgDescription *dynamodb.TableDescription) Provisioning {
-	provisioning := Provisioning{}
+	provisioning := Provisioning{
+		SourceBillingMode: billingMode(srcDescription),
+		TargetBillingMode: billingMode(trgDescription),
+	}
 
-	if *srcDescription.BillingModeSummary.BillingMode == dynamodb.BillingModeProvisioned {
-		provisioning.Source = &Capacity{
-			Write: *srcDescription.ProvisionedThroughput.WriteCapacityUnits,
-			Read:  *srcDescription.ProvisionedThroughput.ReadCapacityUnits,
-		}
+	if provisioning.SourceBillingMode == dynamodb.BillingModeProvisioned {
+		provisioning.Source = newCapacity(srcDescription.ProvisionedThroughput)
+		provisioning.SourceIndexes = newIndexCapacities(srcDescription.GlobalSecondaryIndexes)
 	}
 
-	if *trgDescription.BillingModeSummary.BillingMode == dynamodb.BillingModeProvisioned {
-		provisioning.Target = &Capacity{
-			Write: *trgDescription.ProvisionedThroughput.WriteCapacityUnits,
-			Read:  *trgDescription.ProvisionedThroughput.ReadCapacityUnits,
-		}
+	if provisioning.TargetBillingMode == dynamodb.BillingModeProvisioned {
+		provisioning.Target = newCapacity(trgDescription.ProvisionedThroughput)
+		provisioning.TargetIndexes = newIndexCapacities(trgDescription.GlobalSecondaryIndexes)
 	}
 
 	return provisioning
 }
+
+func newCapacity(throughput *dynamodb.ProvisionedThroughputDescription) *Capacity {
+	return &Capacity{
+		Write: *throughput.WriteCapacityUnits,
+		Read:  *throughput.ReadCapacityUnits,
+	}
+}
+
+func newIndexCapacities(indexes []*dynamodb.GlobalSecondaryIndexDescription) map[string]*Capacity {
+	if len(indexes) == 0 {
+		return nil
+	}
+
+	capacities := make(map[string]*Capacity, len(indexes))
+	for _, index := range indexes {
+		capacities[*index.IndexName] = newCapacity(index.ProvisionedThroughput)
+	}
+
+	return capacities
+}
+
+func billingMode(description *dynamodb.TableDescription) string {
+	summary := description.BillingModeSummary
+	if summary == nil || summary.BillingMode == nil {
+		return dynamodb.BillingModeProvisioned
+	}
+
+	return *summary.BillingMode
+}
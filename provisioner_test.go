@@ -1,14 +1,17 @@
 package dynamodbcopy_test
 
 import (
+	"bytes"
 	"errors"
 	"io/ioutil"
 	"log"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/uniplaces/dynamodbcopy"
 	"github.com/uniplaces/dynamodbcopy/mocks"
 )
@@ -72,6 +75,7 @@ func TestFetch(t *testing.T) {
 				provisioner := dynamodbcopy.NewProvisioner(
 					srcService,
 					trgService,
+					dynamodbcopy.DecreaseWarn,
 					log.New(ioutil.Discard, "", log.Ltime),
 				)
 
@@ -99,6 +103,30 @@ func TestUpdate(t *testing.T) {
 	srcPerRequestDescription := buildTableDescription(srcTableName, dynamodb.BillingModePayPerRequest, 10, 10)
 	trgPerRequestDescription := buildTableDescription(trgTableName, dynamodb.BillingModePayPerRequest, 10, 10)
 
+	trgProvisionedCapacity := &dynamodbcopy.Capacity{Read: 10, Write: 10}
+	trgSwitchedProvisioning := buildProvisioning(srcDefaultDescription, trgPerRequestDescription)
+	trgSwitchedProvisioning.Target = trgProvisionedCapacity
+	trgSwitchedProvisioning.TargetBillingMode = dynamodb.BillingModeProvisioned
+
+	trgIndexDescription := buildDefaultTableDescription(trgTableName)
+	trgIndexDescription.GlobalSecondaryIndexes = []*dynamodb.GlobalSecondaryIndexDescription{
+		buildIndexDescription("index", 5, 5),
+	}
+	trgIndexProvisioning := buildProvisioning(srcDefaultDescription, trgIndexDescription)
+	trgIndexProvisioning.TargetIndexes = map[string]*dynamodbcopy.Capacity{"index": {Read: 5, Write: 10}}
+
+	trgTableAndIndexProvisioning := trgIndexProvisioning
+	trgTableAndIndexProvisioning.Target = &dynamodbcopy.Capacity{Read: 10, Write: 10}
+
+	updateCapacity := &dynamodbcopy.Capacity{Read: 10, Write: 10}
+	noCapacityUpdate := (*dynamodbcopy.Capacity)(nil)
+	noIndexUpdates := map[string]*dynamodbcopy.Capacity(nil)
+	trgIndexUpdates := trgIndexProvisioning.TargetIndexes
+
+	trgOnDemandProvisioning := buildProvisioning(srcDefaultDescription, trgDefaultDescription)
+	trgOnDemandProvisioning.Target = nil
+	trgOnDemandProvisioning.TargetBillingMode = dynamodb.BillingModePayPerRequest
+
 	expectedError := errors.New("dynamo errors")
 
 	testCases := []struct {
@@ -141,7 +169,7 @@ func TestUpdate(t *testing.T) {
 			"SrcUpdateNeeded",
 			func(srcService, trgService *mocks.DynamoDBService) {
 				srcService.On("DescribeTable").Return(&srcDefaultDescription, nil).Once()
-				srcService.On("UpdateCapacity", dynamodbcopy.Capacity{Read: 10, Write: 10}).Return(nil).Once()
+				srcService.On("UpdateProvisioning", updateCapacity, noIndexUpdates).Return(nil).Once()
 				trgService.On("DescribeTable").Return(&trgDefaultDescription, nil).Once()
 			},
 			buildProvisioning(srcDescription, trgDefaultDescription),
@@ -153,7 +181,7 @@ func TestUpdate(t *testing.T) {
 			func(srcService, trgService *mocks.DynamoDBService) {
 				srcService.On("DescribeTable").Return(&srcDefaultDescription, nil).Once()
 				trgService.On("DescribeTable").Return(&trgDefaultDescription, nil).Once()
-				trgService.On("UpdateCapacity", dynamodbcopy.Capacity{Read: 10, Write: 10}).Return(nil).Once()
+				trgService.On("UpdateProvisioning", updateCapacity, noIndexUpdates).Return(nil).Once()
 			},
 			buildProvisioning(srcDefaultDescription, trgDescription),
 			buildProvisioning(srcDefaultDescription, trgDescription),
@@ -163,9 +191,9 @@ func TestUpdate(t *testing.T) {
 			"Update",
 			func(srcService, trgService *mocks.DynamoDBService) {
 				srcService.On("DescribeTable").Return(&srcDefaultDescription, nil).Once()
-				srcService.On("UpdateCapacity", dynamodbcopy.Capacity{Read: 10, Write: 10}).Return(nil).Once()
+				srcService.On("UpdateProvisioning", updateCapacity, noIndexUpdates).Return(nil).Once()
 				trgService.On("DescribeTable").Return(&trgDefaultDescription, nil).Once()
-				trgService.On("UpdateCapacity", dynamodbcopy.Capacity{Read: 10, Write: 10}).Return(nil).Once()
+				trgService.On("UpdateProvisioning", updateCapacity, noIndexUpdates).Return(nil).Once()
 			},
 			buildProvisioning(srcDescription, trgDescription),
 			buildProvisioning(srcDescription, trgDescription),
@@ -181,11 +209,79 @@ func TestUpdate(t *testing.T) {
 			buildProvisioning(srcPerRequestDescription, trgPerRequestDescription),
 			nil,
 		},
+		{
+			"TrgIndexUpdateNeeded",
+			func(srcService, trgService *mocks.DynamoDBService) {
+				srcService.On("DescribeTable").Return(&srcDefaultDescription, nil).Once()
+				trgService.On("DescribeTable").Return(&trgIndexDescription, nil).Once()
+				trgService.On("UpdateProvisioning", noCapacityUpdate, trgIndexUpdates).Return(nil).Once()
+			},
+			trgIndexProvisioning,
+			trgIndexProvisioning,
+			nil,
+		},
+		{
+			"TrgTableAndIndexUpdateNeeded",
+			func(srcService, trgService *mocks.DynamoDBService) {
+				srcService.On("DescribeTable").Return(&srcDefaultDescription, nil).Once()
+				trgService.On("DescribeTable").Return(&trgIndexDescription, nil).Once()
+				trgService.On("UpdateProvisioning", updateCapacity, trgIndexUpdates).Return(nil).Once()
+			},
+			trgTableAndIndexProvisioning,
+			trgTableAndIndexProvisioning,
+			nil,
+		},
+		{
+			"TrgIndexUpdateError",
+			func(srcService, trgService *mocks.DynamoDBService) {
+				srcService.On("DescribeTable").Return(&srcDefaultDescription, nil).Once()
+				trgService.On("DescribeTable").Return(&trgIndexDescription, nil).Once()
+				trgService.On("UpdateProvisioning", noCapacityUpdate, trgIndexUpdates).
+					Return(expectedError).
+					Once()
+			},
+			trgIndexProvisioning,
+			dynamodbcopy.Provisioning{},
+			expectedError,
+		},
+		{
+			"SwitchTrgToProvisioned",
+			func(srcService, trgService *mocks.DynamoDBService) {
+				srcService.On("DescribeTable").Return(&srcDefaultDescription, nil).Once()
+				trgService.On("DescribeTable").Return(&trgPerRequestDescription, nil).Once()
+				trgService.On("UpdateBillingMode", trgProvisionedCapacity).Return(nil).Once()
+			},
+			trgSwitchedProvisioning,
+			trgSwitchedProvisioning,
+			nil,
+		},
+		{
+			"SwitchTrgToOnDemand",
+			func(srcService, trgService *mocks.DynamoDBService) {
+				srcService.On("DescribeTable").Return(&srcDefaultDescription, nil).Once()
+				trgService.On("DescribeTable").Return(&trgDefaultDescription, nil).Once()
+				trgService.On("UpdateBillingMode", (*dynamodbcopy.Capacity)(nil)).Return(nil).Once()
+			},
+			trgOnDemandProvisioning,
+			trgOnDemandProvisioning,
+			nil,
+		},
+		{
+			"SwitchTrgError",
+			func(srcService, trgService *mocks.DynamoDBService) {
+				srcService.On("DescribeTable").Return(&srcDefaultDescription, nil).Once()
+				trgService.On("DescribeTable").Return(&trgPerRequestDescription, nil).Once()
+				trgService.On("UpdateBillingMode", trgProvisionedCapacity).Return(expectedError).Once()
+			},
+			trgSwitchedProvisioning,
+			dynamodbcopy.Provisioning{},
+			expectedError,
+		},
 		{
 			"UpdateSrcError",
 			func(srcService, trgService *mocks.DynamoDBService) {
 				srcService.On("DescribeTable").Return(&srcDefaultDescription, nil).Once()
-				srcService.On("UpdateCapacity", dynamodbcopy.Capacity{Read: 10, Write: 10}).Return(expectedError).Once()
+				srcService.On("UpdateProvisioning", updateCapacity, noIndexUpdates).Return(expectedError).Once()
 				trgService.On("DescribeTable").Return(&trgDefaultDescription, nil).Once()
 			},
 			buildProvisioning(srcDescription, trgDefaultDescription),
@@ -197,7 +293,7 @@ func TestUpdate(t *testing.T) {
 			func(srcService, trgService *mocks.DynamoDBService) {
 				srcService.On("DescribeTable").Return(&srcDefaultDescription, nil).Once()
 				trgService.On("DescribeTable").Return(&trgDefaultDescription, nil).Once()
-				trgService.On("UpdateCapacity", dynamodbcopy.Capacity{Read: 10, Write: 10}).Return(expectedError).Once()
+				trgService.On("UpdateProvisioning", updateCapacity, noIndexUpdates).Return(expectedError).Once()
 			},
 			buildProvisioning(srcDefaultDescription, trgDescription),
 			dynamodbcopy.Provisioning{},
@@ -217,6 +313,7 @@ func TestUpdate(t *testing.T) {
 				provisioner := dynamodbcopy.NewProvisioner(
 					srcService,
 					trgService,
+					dynamodbcopy.DecreaseWarn,
 					log.New(ioutil.Discard, "", log.Ltime),
 				)
 
@@ -232,6 +329,214 @@ func TestUpdate(t *testing.T) {
 	}
 }
 
+func TestUpdateRecentlySwitchedToOnDemand(t *testing.T) {
+	t.Parallel()
+
+	srcDescription := buildDefaultTableDescription(srcTableName)
+	trgDescription := buildTableDescription(trgTableName, dynamodb.BillingModePayPerRequest, 0, 0)
+	trgDescription.BillingModeSummary.LastUpdateToPayPerRequestDateTime = aws.Time(time.Now().Add(-time.Hour))
+
+	srcService := &mocks.DynamoDBService{}
+	srcService.On("DescribeTable").Return(&srcDescription, nil).Once()
+
+	trgService := &mocks.DynamoDBService{}
+	trgService.On("DescribeTable").Return(&trgDescription, nil).Once()
+
+	provisioning := buildProvisioning(srcDescription, trgDescription)
+	provisioning.Target = &dynamodbcopy.Capacity{Read: 10, Write: 10}
+	provisioning.TargetBillingMode = dynamodb.BillingModeProvisioned
+
+	provisioner := dynamodbcopy.NewProvisioner(
+		srcService,
+		trgService,
+		dynamodbcopy.DecreaseWarn,
+		log.New(ioutil.Discard, "", log.Ltime),
+	)
+
+	_, err := provisioner.Update(provisioning)
+
+	assert.NotNil(t, err)
+
+	srcService.AssertExpectations(t)
+	trgService.AssertExpectations(t)
+}
+
+func TestUpdateDecreasePolicy(t *testing.T) {
+	t.Parallel()
+
+	srcDescription := buildDefaultTableDescription(srcTableName)
+	trgDescription := buildDefaultTableDescription(trgTableName)
+	trgDescription.ProvisionedThroughput.NumberOfDecreasesToday = aws.Int64(4)
+	trgDescription.GlobalSecondaryIndexes = []*dynamodb.GlobalSecondaryIndexDescription{
+		buildIndexDescription("index", 5, 5),
+	}
+	trgDescription.GlobalSecondaryIndexes[0].ProvisionedThroughput.NumberOfDecreasesToday = aws.Int64(4)
+
+	updateCapacity := dynamodbcopy.Capacity{Read: 5, Write: 10}
+
+	provisioning := buildProvisioning(srcDescription, trgDescription)
+	provisioning.Target = &updateCapacity
+	provisioning.TargetIndexes = map[string]*dynamodbcopy.Capacity{"index": &updateCapacity}
+
+	testCases := []struct {
+		subTestName          string
+		policy               dynamodbcopy.DecreasePolicy
+		mocker               func(trgService *mocks.DynamoDBService)
+		expectedProvisioning dynamodbcopy.Provisioning
+		expectedWarning      string
+		errorExpected        bool
+	}{
+		{
+			"Warn",
+			dynamodbcopy.DecreaseWarn,
+			func(trgService *mocks.DynamoDBService) {
+				trgService.On("UpdateProvisioning", &updateCapacity, provisioning.TargetIndexes).Return(nil).Once()
+			},
+			provisioning,
+			"WARN raising target table",
+			false,
+		},
+		{
+			"Skip",
+			dynamodbcopy.DecreaseSkip,
+			func(trgService *mocks.DynamoDBService) {},
+			buildProvisioning(srcDescription, trgDescription),
+			"WARN keeping target table",
+			false,
+		},
+		{
+			"Fail",
+			dynamodbcopy.DecreaseFail,
+			func(trgService *mocks.DynamoDBService) {},
+			dynamodbcopy.Provisioning{},
+			"",
+			true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.subTestName,
+			func(st *testing.T) {
+				srcService := &mocks.DynamoDBService{}
+				srcService.On("DescribeTable").Return(&srcDescription, nil).Once()
+
+				trgService := &mocks.DynamoDBService{}
+				trgService.On("DescribeTable").Return(&trgDescription, nil).Once()
+
+				testCase.mocker(trgService)
+
+				buffer := &bytes.Buffer{}
+				provisioner := dynamodbcopy.NewProvisioner(
+					srcService,
+					trgService,
+					testCase.policy,
+					dynamodbcopy.NewDebugLogger(
+						dynamodbcopy.NewLeveledLogger(buffer, dynamodbcopy.LogFormatText, dynamodbcopy.LevelInfo),
+						false,
+					),
+				)
+
+				updatedProvisioning, err := provisioner.Update(provisioning)
+
+				assertExpectedError(st, testCase.errorExpected, err)
+				assert.Equal(st, testCase.expectedProvisioning, updatedProvisioning)
+				assert.Contains(st, buffer.String(), testCase.expectedWarning)
+
+				srcService.AssertExpectations(st)
+				trgService.AssertExpectations(st)
+			},
+		)
+	}
+}
+
+func TestTableProvisioner(t *testing.T) {
+	t.Parallel()
+
+	initialDescription := buildTableDescription(srcTableName, dynamodb.BillingModeProvisioned, 5, 5)
+	initialDescription.GlobalSecondaryIndexes = []*dynamodb.GlobalSecondaryIndexDescription{
+		buildIndexDescription("index", 5, 5),
+	}
+	raisedDescription := buildTableDescription(srcTableName, dynamodb.BillingModeProvisioned, 10, 20)
+	raisedDescription.GlobalSecondaryIndexes = []*dynamodb.GlobalSecondaryIndexDescription{
+		buildIndexDescription("index", 5, 20),
+	}
+
+	tableService := &mocks.DynamoDBService{}
+	tableService.On("DescribeTable").Return(&initialDescription, nil).Twice()
+	tableService.On(
+		"UpdateProvisioning",
+		&dynamodbcopy.Capacity{Read: 10, Write: 20},
+		map[string]*dynamodbcopy.Capacity{"index": {Read: 5, Write: 20}},
+	).Return(nil).Once()
+	tableService.On("DescribeTable").Return(&raisedDescription, nil).Once()
+	tableService.On(
+		"UpdateProvisioning",
+		&dynamodbcopy.Capacity{Read: 5, Write: 5},
+		map[string]*dynamodbcopy.Capacity{"index": {Read: 5, Write: 5}},
+	).Return(nil).Once()
+
+	provisioner := dynamodbcopy.NewTableProvisioner(
+		tableService,
+		dynamodbcopy.DecreaseWarn,
+		log.New(ioutil.Discard, "", log.Ltime),
+	)
+
+	initial, err := provisioner.Fetch()
+	require.Nil(t, err)
+	assert.Nil(t, initial.Target)
+	assert.Nil(t, initial.TargetIndexes)
+
+	raised, err := provisioner.Update(dynamodbcopy.NewConfig(10, 20, 1, 1).TableProvisioning(initial))
+	require.Nil(t, err)
+	assert.Equal(t, &dynamodbcopy.Capacity{Read: 10, Write: 20}, raised.Source)
+
+	restored, err := provisioner.Update(initial)
+	require.Nil(t, err)
+	assert.Equal(t, initial, restored)
+
+	tableService.AssertExpectations(t)
+}
+
+func TestParseDecreasePolicy(t *testing.T) {
+	t.Parallel()
+
+	policy, err := dynamodbcopy.ParseDecreasePolicy("skip")
+
+	assert.Nil(t, err)
+	assert.Equal(t, dynamodbcopy.DecreaseSkip, policy)
+
+	_, err = dynamodbcopy.ParseDecreasePolicy("invalid")
+
+	assert.NotNil(t, err)
+}
+
+func TestNewProvisioning(t *testing.T) {
+	t.Parallel()
+
+	srcDescription := buildDefaultTableDescription(srcTableName)
+	srcDescription.BillingModeSummary = nil
+	srcDescription.GlobalSecondaryIndexes = []*dynamodb.GlobalSecondaryIndexDescription{
+		buildIndexDescription("index", 10, 15),
+	}
+
+	trgDescription := buildTableDescription(trgTableName, dynamodb.BillingModePayPerRequest, 0, 0)
+	trgDescription.GlobalSecondaryIndexes = []*dynamodb.GlobalSecondaryIndexDescription{
+		buildIndexDescription("index", 0, 0),
+	}
+
+	provisioning := dynamodbcopy.NewProvisioning(&srcDescription, &trgDescription)
+
+	expectedProvisioning := dynamodbcopy.Provisioning{
+		Source:            &dynamodbcopy.Capacity{Read: 5, Write: 5},
+		SourceIndexes:     map[string]*dynamodbcopy.Capacity{"index": {Read: 10, Write: 15}},
+		SourceBillingMode: dynamodb.BillingModeProvisioned,
+		TargetBillingMode: dynamodb.BillingModePayPerRequest,
+	}
+
+	assert.Equal(t, expectedProvisioning, provisioning)
+}
+
 func buildProvisioning(src, trg dynamodb.TableDescription) dynamodbcopy.Provisioning {
 	return dynamodbcopy.NewProvisioning(&src, &trg)
 }
@@ -252,3 +557,13 @@ func buildTableDescription(table, billingMode string, r, w int64) dynamodb.Table
 		},
 	}
 }
+
+func buildIndexDescription(index string, r, w int64) *dynamodb.GlobalSecondaryIndexDescription {
+	return &dynamodb.GlobalSecondaryIndexDescription{
+		IndexName: aws.String(index),
+		ProvisionedThroughput: &dynamodb.ProvisionedThroughputDescription{
+			ReadCapacityUnits:  aws.Int64(r),
+			WriteCapacityUnits: aws.Int64(w),
+		},
+	}
+}
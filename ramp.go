@@ -0,0 +1,207 @@
+package dynamodbcopy
+
+import (
+	"sync"
+	"time"
+)
+
+// rampUpThreshold is the fraction of the current ramp capacity units that has to be consumed to step up the capacity
+const rampUpThreshold = 0.8
+
+// Ramp raises the capacity of the tables towards the Config capacity units in steps while the copy is running.
+//
+// Every interval, the capacity is stepped up on the dimensions (source read, target write) whose consumed capacity
+// reached rampUpThreshold of their current capacity, so that a table is only raised as its throughput is used.
+// Once the copy finishes, the capacity is ramped down towards the initial provisioning in the configured number of
+// steps. Keep in mind that each down step counts as a capacity decrease in DynamoDB's daily limit
+type Ramp struct {
+	provisioner Provisioner
+	srcTable    DynamoDBService
+	trgTable    DynamoDBService
+	config      Config
+	sleep       Sleeper
+	logger      Logger
+
+	mutex    *sync.Mutex
+	initial  Provisioning
+	read     int64
+	write    int64
+	consumed ConsumedCapacity
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// NewRamp creates a new Ramp that updates the tables provisioning with the given Provisioner
+func NewRamp(
+	provisioner Provisioner,
+	srcTable, trgTable DynamoDBService,
+	config Config,
+	sleepFn Sleeper,
+	logger Logger,
+) *Ramp {
+	return &Ramp{
+		provisioner: provisioner,
+		srcTable:    srcTable,
+		trgTable:    trgTable,
+		config:      config,
+		sleep:       sleepFn,
+		logger:      logger,
+		mutex:       &sync.Mutex{},
+	}
+}
+
+// Start updates the tables provisioning to the first step above the initial Provisioning and starts stepping up
+// the capacity every interval, until Stop is called.
+// When the ramp is disabled, the tables are provisioned with the Config capacity units right away
+func (r *Ramp) Start(initial Provisioning) (Provisioning, error) {
+	if r.config.rampStep <= 0 {
+		return r.provisioner.Update(r.config.Provisioning(initial))
+	}
+
+	r.initial = initial
+	r.read = r.firstStep(initial.Source, r.config.readCapacityUnits, func(c *Capacity) int64 { return c.Read })
+	r.write = r.firstStep(initial.Target, r.config.writeCapacityUnits, func(c *Capacity) int64 { return c.Write })
+	r.consumed = r.consumedCapacity()
+
+	updated, err := r.provisioner.Update(r.config.withCapacityUnits(r.read, r.write).Provisioning(initial))
+	if err != nil {
+		return Provisioning{}, err
+	}
+	r.logger.Printf("ramped capacity to r: %d w: %d", r.read, r.write)
+
+	r.stop = make(chan struct{})
+	r.done = make(chan struct{})
+	go r.run()
+
+	return updated, nil
+}
+
+func (r *Ramp) firstStep(current *Capacity, ceiling int64, units func(*Capacity) int64) int64 {
+	var step int64
+	if current != nil {
+		step = units(current)
+	}
+
+	return min64(step+r.config.rampStep, ceiling)
+}
+
+func (r *Ramp) run() {
+	defer close(r.done)
+
+	ticker := time.NewTicker(r.config.rampInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.stop:
+			return
+		case <-ticker.C:
+			if err := r.Step(); err != nil {
+				logWith(r.logger, LevelWarn, nil, "stopped ramping up capacity: %s", err)
+
+				return
+			}
+		}
+	}
+}
+
+// Step raises the capacity of the dimensions whose capacity consumed since the previous step reached
+// rampUpThreshold of their current capacity, without exceeding the Config capacity units.
+// It's called by the Ramp every interval after Start
+func (r *Ramp) Step() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	consumed := r.consumedCapacity()
+	read := r.nextStep(r.read, r.config.readCapacityUnits, consumed.Read-r.consumed.Read)
+	write := r.nextStep(r.write, r.config.writeCapacityUnits, consumed.Write-r.consumed.Write)
+	r.consumed = consumed
+
+	if read == r.read && write == r.write {
+		return nil
+	}
+
+	if _, err := r.provisioner.Update(r.config.withCapacityUnits(read, write).Provisioning(r.initial)); err != nil {
+		return err
+	}
+	r.read, r.write = read, write
+	r.logger.Printf("ramped capacity to r: %d w: %d", r.read, r.write)
+
+	return nil
+}
+
+func (r *Ramp) nextStep(units, ceiling int64, consumed float64) int64 {
+	if units >= ceiling || consumed < rampUpThreshold*float64(units)*r.config.rampInterval.Seconds() {
+		return units
+	}
+
+	return min64(units+r.config.rampStep, ceiling)
+}
+
+func (r *Ramp) consumedCapacity() ConsumedCapacity {
+	return ConsumedCapacity{
+		Read:  r.srcTable.ConsumedCapacity().Read,
+		Write: r.trgTable.ConsumedCapacity().Write,
+	}
+}
+
+// Stop stops stepping up the capacity, waiting for any ongoing step to finish
+func (r *Ramp) Stop() {
+	if r.stop == nil {
+		return
+	}
+
+	close(r.stop)
+	<-r.done
+	r.stop = nil
+}
+
+// RampDown lowers the capacity of the tables towards the initial Provisioning in the configured number of down
+// steps, waiting an interval after each one. The last step, restoring the initial Provisioning, is left to the caller
+func (r *Ramp) RampDown() error {
+	if r.config.rampStep <= 0 {
+		return nil
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	initialRead, initialWrite := r.read, r.write
+	if r.initial.Source != nil {
+		initialRead = min64(r.initial.Source.Read, r.read)
+	}
+	if r.initial.Target != nil {
+		initialWrite = min64(r.initial.Target.Write, r.write)
+	}
+
+	steps := int64(r.config.rampDownSteps)
+	for i := int64(1); i < steps; i++ {
+		read := r.read - (r.read-initialRead)*i/steps
+		write := r.write - (r.write-initialWrite)*i/steps
+
+		if _, err := r.provisioner.Update(r.config.withCapacityUnits(read, write).Provisioning(r.initial)); err != nil {
+			return err
+		}
+		r.logger.Printf("ramped down capacity to r: %d w: %d", read, write)
+
+		r.sleep(int(r.config.rampInterval / time.Millisecond))
+	}
+
+	return nil
+}
+
+func min64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+
+	return b
+}
+
+func max64(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,249 @@
+package dynamodbcopy_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/uniplaces/dynamodbcopy"
+	"github.com/uniplaces/dynamodbcopy/mocks"
+)
+
+func TestRampDisabled(t *testing.T) {
+	t.Parallel()
+
+	config := dynamodbcopy.NewConfig(100, 100, 1, 1)
+	initial := buildProvision(10, 10)
+
+	provisioner := &mocks.Provisioner{}
+	provisioner.On("Update", buildProvision(100, 100)).Return(buildProvision(100, 100), nil).Once()
+
+	ramp := dynamodbcopy.NewRamp(
+		provisioner,
+		&mocks.DynamoDBService{},
+		&mocks.DynamoDBService{},
+		config,
+		testSleeper,
+		log.New(ioutil.Discard, "", log.Ltime),
+	)
+
+	updated, err := ramp.Start(initial)
+	require.Nil(t, err)
+	assert.Equal(t, buildProvision(100, 100), updated)
+
+	ramp.Stop()
+	require.Nil(t, ramp.RampDown())
+
+	provisioner.AssertExpectations(t)
+}
+
+func TestRamp(t *testing.T) {
+	t.Parallel()
+
+	// an hour interval keeps the ramp from stepping on its own, steps are triggered by the test
+	config := dynamodbcopy.NewConfig(100, 100, 1, 1).WithRamp(40, time.Hour, 3)
+	initial := buildProvision(10, 10)
+
+	srcService := &mocks.DynamoDBService{}
+	srcService.On("ConsumedCapacity").Return(dynamodbcopy.ConsumedCapacity{}).Once()
+	srcService.On("ConsumedCapacity").Return(dynamodbcopy.ConsumedCapacity{Read: 144000}).Once()
+	srcService.On("ConsumedCapacity").Return(dynamodbcopy.ConsumedCapacity{Read: 403200}).Twice()
+
+	trgService := &mocks.DynamoDBService{}
+	trgService.On("ConsumedCapacity").Return(dynamodbcopy.ConsumedCapacity{}).Twice()
+	trgService.On("ConsumedCapacity").Return(dynamodbcopy.ConsumedCapacity{Write: 144000}).Twice()
+
+	provisioner := &mocks.Provisioner{}
+	for _, provisioning := range []dynamodbcopy.Provisioning{
+		buildProvision(50, 50),
+		buildProvision(90, 50),
+		buildProvision(100, 90),
+		buildProvision(70, 64),
+		buildProvision(40, 37),
+	} {
+		provisioner.On("Update", provisioning).Return(provisioning, nil).Once()
+	}
+
+	sleeps := 0
+	sleeper := func(ms int) int {
+		sleeps++
+
+		return ms
+	}
+
+	ramp := dynamodbcopy.NewRamp(
+		provisioner,
+		srcService,
+		trgService,
+		config,
+		sleeper,
+		log.New(ioutil.Discard, "", log.Ltime),
+	)
+
+	updated, err := ramp.Start(initial)
+	require.Nil(t, err)
+	assert.Equal(t, buildProvision(50, 50), updated)
+
+	for i := 0; i < 3; i++ {
+		require.Nil(t, ramp.Step())
+	}
+
+	ramp.Stop()
+	require.Nil(t, ramp.RampDown())
+
+	assert.Equal(t, 2, sleeps)
+
+	srcService.AssertExpectations(t)
+	trgService.AssertExpectations(t)
+	provisioner.AssertExpectations(t)
+}
+
+func TestRampErrors(t *testing.T) {
+	t.Parallel()
+
+	expectedError := errors.New("update error")
+	config := dynamodbcopy.NewConfig(100, 100, 1, 1).WithRamp(40, time.Hour, 2)
+	initial := buildProvision(10, 10)
+
+	testCases := []struct {
+		subTestName string
+		mocker      func(provisioner *mocks.Provisioner)
+		ramp        func(ramp *dynamodbcopy.Ramp) error
+	}{
+		{
+			"StartError",
+			func(provisioner *mocks.Provisioner) {
+				provisioner.On("Update", buildProvision(50, 50)).Return(dynamodbcopy.Provisioning{}, expectedError).Once()
+			},
+			func(ramp *dynamodbcopy.Ramp) error {
+				_, err := ramp.Start(initial)
+
+				return err
+			},
+		},
+		{
+			"StepError",
+			func(provisioner *mocks.Provisioner) {
+				provisioner.On("Update", buildProvision(50, 50)).Return(buildProvision(50, 50), nil).Once()
+				provisioner.On("Update", buildProvision(90, 90)).Return(dynamodbcopy.Provisioning{}, expectedError).Once()
+			},
+			func(ramp *dynamodbcopy.Ramp) error {
+				if _, err := ramp.Start(initial); err != nil {
+					return err
+				}
+				defer ramp.Stop()
+
+				return ramp.Step()
+			},
+		},
+		{
+			"RampDownError",
+			func(provisioner *mocks.Provisioner) {
+				provisioner.On("Update", buildProvision(50, 50)).Return(buildProvision(50, 50), nil).Once()
+				provisioner.On("Update", buildProvision(30, 30)).Return(dynamodbcopy.Provisioning{}, expectedError).Once()
+			},
+			func(ramp *dynamodbcopy.Ramp) error {
+				if _, err := ramp.Start(initial); err != nil {
+					return err
+				}
+				ramp.Stop()
+
+				return ramp.RampDown()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.subTestName,
+			func(st *testing.T) {
+				consumed := dynamodbcopy.ConsumedCapacity{}
+				srcService := &mocks.DynamoDBService{}
+				srcService.On("ConsumedCapacity").Return(func() dynamodbcopy.ConsumedCapacity {
+					consumed.Read += 144000
+
+					return consumed
+				})
+				trgService := &mocks.DynamoDBService{}
+				trgService.On("ConsumedCapacity").Return(func() dynamodbcopy.ConsumedCapacity {
+					consumed.Write += 144000
+
+					return consumed
+				})
+
+				provisioner := &mocks.Provisioner{}
+				testCase.mocker(provisioner)
+
+				ramp := dynamodbcopy.NewRamp(
+					provisioner,
+					srcService,
+					trgService,
+					config,
+					testSleeper,
+					log.New(ioutil.Discard, "", log.Ltime),
+				)
+
+				assert.Equal(st, expectedError, testCase.ramp(ramp))
+
+				provisioner.AssertExpectations(st)
+			},
+		)
+	}
+}
+
+func TestRampStepErrorWarning(t *testing.T) {
+	t.Parallel()
+
+	config := dynamodbcopy.NewConfig(100, 100, 1, 1).WithRamp(40, time.Millisecond, 1)
+
+	failed := make(chan struct{})
+	provisioner := &mocks.Provisioner{}
+	provisioner.On("Update", buildProvision(50, 50)).Return(buildProvision(50, 50), nil).Once()
+	provisioner.On("Update", buildProvision(90, 90)).Run(func(args mock.Arguments) {
+		close(failed)
+	}).Return(dynamodbcopy.Provisioning{}, errors.New("update error")).Once()
+
+	// the ramp only steps up once the consumed capacity reaches its threshold
+	consumed := dynamodbcopy.ConsumedCapacity{}
+	srcService := &mocks.DynamoDBService{}
+	srcService.On("ConsumedCapacity").Return(func() dynamodbcopy.ConsumedCapacity {
+		consumed.Read += 144000
+
+		return consumed
+	})
+	trgService := &mocks.DynamoDBService{}
+	trgService.On("ConsumedCapacity").Return(func() dynamodbcopy.ConsumedCapacity {
+		consumed.Write += 144000
+
+		return consumed
+	})
+
+	buffer := &bytes.Buffer{}
+	ramp := dynamodbcopy.NewRamp(
+		provisioner,
+		srcService,
+		trgService,
+		config,
+		testSleeper,
+		dynamodbcopy.NewDebugLogger(
+			dynamodbcopy.NewLeveledLogger(buffer, dynamodbcopy.LogFormatText, dynamodbcopy.LevelInfo),
+			false,
+		),
+	)
+
+	_, err := ramp.Start(buildProvision(10, 10))
+	require.Nil(t, err)
+
+	<-failed
+	ramp.Stop()
+
+	assert.Contains(t, buffer.String(), "WARN stopped ramping up capacity: update error")
+
+	provisioner.AssertExpectations(t)
+}
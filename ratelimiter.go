@@ -0,0 +1,131 @@
+package dynamodbcopy
+
+import (
+	"sync"
+	"time"
+)
+
+// RateLimiter limits operations to a maximum number of units per second.
+// It is safe for concurrent use and its limit can be changed at any time
+type RateLimiter struct {
+	mutex          *sync.Mutex
+	unitsPerSecond int64
+	next           time.Time
+	sleep          Sleeper
+}
+
+// NewRateLimiter creates a new RateLimiter without any limit, that uses sleepFn to wait
+func NewRateLimiter(sleepFn Sleeper) *RateLimiter {
+	return &RateLimiter{
+		mutex: &sync.Mutex{},
+		sleep: sleepFn,
+	}
+}
+
+// SetLimit sets the maximum units per second allowed by the limiter. A limit of 0 disables the limiter
+func (l *RateLimiter) SetLimit(unitsPerSecond int64) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.unitsPerSecond = unitsPerSecond
+}
+
+// Wait blocks until the units consumed so far are within the limit
+func (l *RateLimiter) Wait() {
+	l.mutex.Lock()
+	if l.unitsPerSecond <= 0 {
+		l.mutex.Unlock()
+
+		return
+	}
+
+	wait := time.Until(l.next)
+	l.mutex.Unlock()
+
+	if wait > 0 {
+		l.sleep(int(wait / time.Millisecond))
+	}
+}
+
+// Consume charges the given consumed units to the limiter, delaying the following waits accordingly
+func (l *RateLimiter) Consume(units float64) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.unitsPerSecond <= 0 || units <= 0 {
+		return
+	}
+
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	l.next = l.next.Add(time.Duration(units * float64(time.Second) / float64(l.unitsPerSecond)))
+}
+
+type rateLimitedService struct {
+	DynamoDBService
+	limiter *RateLimiter
+	mutex   *sync.Mutex
+	charged *ConsumedCapacity
+}
+
+// NewRateLimitedDynamoDBService wraps the given DynamoDBService so its scans and batch writes are limited by limiter.
+//
+// The limiter is charged with the capacity units consumed by the wrapped DynamoDBService, as reported by DynamoDB,
+// so that items larger than a capacity unit are limited by their actual cost
+func NewRateLimitedDynamoDBService(service DynamoDBService, limiter *RateLimiter) DynamoDBService {
+	return rateLimitedService{
+		DynamoDBService: service,
+		limiter:         limiter,
+		mutex:           &sync.Mutex{},
+		charged:         &ConsumedCapacity{},
+	}
+}
+
+// BatchWrite waits for the limiter before writing the given items into the wrapped DynamoDBService,
+// charging it with the write units consumed afterwards
+func (s rateLimitedService) BatchWrite(items []DynamoDBItem) error {
+	s.limiter.Wait()
+
+	err := s.DynamoDBService.BatchWrite(items)
+	s.limiter.Consume(s.consumed().Write)
+
+	return err
+}
+
+// Scan performs a scan over the wrapped DynamoDBService, waiting for the limiter before sending each scanned page
+// into the provided itemsChan and charging it with the read units consumed by the page
+func (s rateLimitedService) Scan(totalSegments, segment int, itemsChan chan<- []DynamoDBItem) error {
+	limitedChan := make(chan []DynamoDBItem)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		for items := range limitedChan {
+			s.limiter.Wait()
+			s.limiter.Consume(s.consumed().Read)
+			itemsChan <- items
+		}
+	}()
+
+	err := s.DynamoDBService.Scan(totalSegments, segment, limitedChan)
+	close(limitedChan)
+	<-done
+
+	return err
+}
+
+// consumed returns the capacity units consumed by the wrapped DynamoDBService since the previous call,
+// so that the units consumed by concurrent requests are only charged once
+func (s rateLimitedService) consumed() ConsumedCapacity {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	total := s.DynamoDBService.ConsumedCapacity()
+	consumed := ConsumedCapacity{Read: total.Read - s.charged.Read, Write: total.Write - s.charged.Write}
+	*s.charged = total
+
+	return consumed
+}
@@ -0,0 +1,134 @@
+package dynamodbcopy_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/uniplaces/dynamodbcopy"
+	"github.com/uniplaces/dynamodbcopy/mocks"
+)
+
+func TestRateLimiterWait(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		subTestName    string
+		limit          int64
+		units          []float64
+		expectedCalled int
+	}{
+		{
+			"NoLimit",
+			0,
+			[]float64{100, 100},
+			0,
+		},
+		{
+			"WithinLimit",
+			100,
+			[]float64{100},
+			0,
+		},
+		{
+			"ExceedsLimit",
+			100,
+			[]float64{100, 100, 100},
+			2,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.subTestName,
+			func(st *testing.T) {
+				called := 0
+				sleeperFn := func(ms int) int {
+					called++
+
+					return ms
+				}
+
+				limiter := dynamodbcopy.NewRateLimiter(sleeperFn)
+				limiter.SetLimit(testCase.limit)
+
+				for _, units := range testCase.units {
+					limiter.Wait()
+					limiter.Consume(units)
+				}
+
+				assert.Equal(st, testCase.expectedCalled, called)
+			},
+		)
+	}
+}
+
+func TestRateLimitedBatchWrite(t *testing.T) {
+	t.Parallel()
+
+	expectedError := errors.New("batchWriteError")
+	items := buildItems(2)
+
+	// each batch write consumes 20 write units, 2 seconds worth of the 10 units per second limit
+	service := &mocks.DynamoDBService{}
+	service.On("BatchWrite", items).Return(nil).Once()
+	service.On("BatchWrite", items).Return(expectedError).Once()
+	service.On("ConsumedCapacity").Return(dynamodbcopy.ConsumedCapacity{Write: 20}).Once()
+	service.On("ConsumedCapacity").Return(dynamodbcopy.ConsumedCapacity{Write: 40}).Once()
+
+	var sleeps []int
+	limiter := dynamodbcopy.NewRateLimiter(func(ms int) int {
+		sleeps = append(sleeps, ms)
+
+		return ms
+	})
+	limiter.SetLimit(10)
+	limitedService := dynamodbcopy.NewRateLimitedDynamoDBService(service, limiter)
+
+	require.Nil(t, limitedService.BatchWrite(items))
+	assert.Equal(t, expectedError, limitedService.BatchWrite(items))
+
+	require.Len(t, sleeps, 1)
+	assert.InDelta(t, 2000, sleeps[0], 100)
+
+	service.AssertExpectations(t)
+}
+
+func TestRateLimitedScan(t *testing.T) {
+	t.Parallel()
+
+	expectedError := errors.New("scanError")
+	items := buildItems(2)
+
+	// each scanned page consumes 5 read units, 1 second worth of the 5 units per second limit
+	service := &mocks.DynamoDBService{}
+	service.On("Scan", 2, 1, mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(2).(chan<- []dynamodbcopy.DynamoDBItem) <- items
+		args.Get(2).(chan<- []dynamodbcopy.DynamoDBItem) <- items
+	}).Return(expectedError).Once()
+	service.On("ConsumedCapacity").Return(dynamodbcopy.ConsumedCapacity{Read: 5}).Once()
+	service.On("ConsumedCapacity").Return(dynamodbcopy.ConsumedCapacity{Read: 10}).Once()
+
+	var sleeps []int
+	limiter := dynamodbcopy.NewRateLimiter(func(ms int) int {
+		sleeps = append(sleeps, ms)
+
+		return ms
+	})
+	limiter.SetLimit(5)
+	limitedService := dynamodbcopy.NewRateLimitedDynamoDBService(service, limiter)
+
+	itemsChan := make(chan []dynamodbcopy.DynamoDBItem, 2)
+	err := limitedService.Scan(2, 1, itemsChan)
+
+	assert.Equal(t, expectedError, err)
+	assert.Equal(t, items, <-itemsChan)
+	assert.Equal(t, items, <-itemsChan)
+
+	require.Len(t, sleeps, 1)
+	assert.InDelta(t, 1000, sleeps[0], 100)
+
+	service.AssertExpectations(t)
+}
@@ -0,0 +1,55 @@
+package dynamodbcopy
+
+import "sync/atomic"
+
+// WriteReport counts the outcome of the item writes of a copy. It is safe for concurrent use
+type WriteReport struct {
+	written                 int64
+	skippedExisting         int64
+	skippedOlder            int64
+	skippedWithoutAttribute int64
+	deleted                 int64
+}
+
+// NewWriteReport creates a new empty WriteReport
+func NewWriteReport() *WriteReport {
+	return &WriteReport{}
+}
+
+// Written returns the number of items written into the target table
+func (r *WriteReport) Written() int64 {
+	return atomic.LoadInt64(&r.written)
+}
+
+// SkippedExisting returns the number of items skipped because they already existed in the target table
+func (r *WriteReport) SkippedExisting() int64 {
+	return atomic.LoadInt64(&r.skippedExisting)
+}
+
+// SkippedOlder returns the number of items skipped because they weren't newer than the target items
+func (r *WriteReport) SkippedOlder() int64 {
+	return atomic.LoadInt64(&r.skippedOlder)
+}
+
+// SkippedWithoutAttribute returns the number of existing items that weren't overwritten because the source items
+// didn't have the attribute to compare
+func (r *WriteReport) SkippedWithoutAttribute() int64 {
+	return atomic.LoadInt64(&r.skippedWithoutAttribute)
+}
+
+// Deleted returns the number of extraneous items deleted from the target table
+func (r *WriteReport) Deleted() int64 {
+	return atomic.LoadInt64(&r.deleted)
+}
+
+// Print logs the WriteReport
+func (r *WriteReport) Print(logger Logger) {
+	logger.Printf(
+		"written items: %d, skipped existing: %d, skipped older: %d, skipped without attribute: %d, deleted: %d",
+		r.Written(),
+		r.SkippedExisting(),
+		r.SkippedOlder(),
+		r.SkippedWithoutAttribute(),
+		r.Deleted(),
+	)
+}
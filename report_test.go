@@ -0,0 +1,24 @@
+package dynamodbcopy_test
+
+import (
+	"bytes"
+	"log"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uniplaces/dynamodbcopy"
+)
+
+func TestWriteReportPrint(t *testing.T) {
+	t.Parallel()
+
+	buffer := &bytes.Buffer{}
+
+	dynamodbcopy.NewWriteReport().Print(log.New(buffer, "", 0))
+
+	assert.Equal(
+		t,
+		"written items: 0, skipped existing: 0, skipped older: 0, skipped without attribute: 0, deleted: 0\n",
+		buffer.String(),
+	)
+}
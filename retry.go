@@ -0,0 +1,130 @@
+package dynamodbcopy
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// Default retry policy values
+const (
+	DefaultRetryMaxElapsed = 3 * time.Minute
+	DefaultRetryBaseDelay  = 100 * time.Millisecond
+	DefaultRetryMaxDelay   = 20 * time.Second
+	// DefaultTableWaitMaxElapsed is the max time spent waiting for a table to be ready, as creating or updating a
+	// table (or its indexes) can take much longer than the retries of a throttled request
+	DefaultTableWaitMaxElapsed = 30 * time.Minute
+)
+
+// RetryPolicy decides whether and after how long a failed or throttled request is retried
+type RetryPolicy interface {
+	// Delay returns the delay before the next attempt of a request, given the number of attempts performed so far,
+	// the time elapsed waiting between them and the previous delay, or false when the request must not be retried
+	Delay(attempts int, elapsed, previous time.Duration) (time.Duration, bool)
+}
+
+// Jitter is the strategy randomizing the exponential delays of a backoffPolicy
+type Jitter string
+
+// Jitter strategies
+const (
+	// JitterFull picks a delay between 0 and the exponential delay of the attempt
+	JitterFull Jitter = "full"
+	// JitterDecorrelated picks a delay between the base delay and three times the previous delay
+	JitterDecorrelated Jitter = "decorrelated"
+)
+
+// ParseJitter parses a jitter strategy: full or decorrelated
+func ParseJitter(value string) (Jitter, error) {
+	switch jitter := Jitter(value); jitter {
+	case JitterFull, JitterDecorrelated:
+		return jitter, nil
+	default:
+		return "", fmt.Errorf("invalid retry jitter %q: expected full or decorrelated", value)
+	}
+}
+
+type backoffPolicy struct {
+	maxAttempts int
+	maxElapsed  time.Duration
+	baseDelay   time.Duration
+	maxDelay    time.Duration
+	jitter      Jitter
+	random      func(n int64) int64
+}
+
+// NewRetryPolicy returns a RetryPolicy with exponential backoff from baseDelay up to maxDelay, randomized with the
+// given jitter, retrying until a request is attempted maxAttempts times or maxElapsed is spent waiting between
+// attempts. A maxAttempts or maxElapsed of 0 doesn't limit the retries
+func NewRetryPolicy(maxAttempts int, maxElapsed, baseDelay, maxDelay time.Duration, jitter Jitter) RetryPolicy {
+	return backoffPolicy{
+		maxAttempts: maxAttempts,
+		maxElapsed:  maxElapsed,
+		baseDelay:   baseDelay,
+		maxDelay:    maxDelay,
+		jitter:      jitter,
+		random:      rand.Int63n,
+	}
+}
+
+// DefaultRetryPolicy returns the RetryPolicy used unless another one is configured: exponential backoff from 100 ms
+// up to 20 seconds with full jitter, for at most 3 minutes
+func DefaultRetryPolicy() RetryPolicy {
+	return NewRetryPolicy(0, DefaultRetryMaxElapsed, DefaultRetryBaseDelay, DefaultRetryMaxDelay, JitterFull)
+}
+
+// DefaultTableWaitPolicy returns the RetryPolicy of the waits for a table to be ready unless another one is configured:
+// exponential backoff from 100 ms up to 20 seconds with full jitter, for at most 30 minutes
+func DefaultTableWaitPolicy() RetryPolicy {
+	return NewRetryPolicy(0, DefaultTableWaitMaxElapsed, DefaultRetryBaseDelay, DefaultRetryMaxDelay, JitterFull)
+}
+
+// Delay returns the delay before the next attempt, unless the attempts or elapsed time are exhausted
+func (p backoffPolicy) Delay(attempts int, elapsed, previous time.Duration) (time.Duration, bool) {
+	if p.maxAttempts > 0 && attempts >= p.maxAttempts {
+		return 0, false
+	}
+
+	if p.maxElapsed > 0 && elapsed >= p.maxElapsed {
+		return 0, false
+	}
+
+	if p.jitter == JitterDecorrelated {
+		upper := p.cap(3 * previous)
+		if upper <= p.baseDelay {
+			return p.cap(p.baseDelay), true
+		}
+
+		return p.baseDelay + p.randomDelay(upper-p.baseDelay), true
+	}
+
+	return p.randomDelay(p.exponentialDelay(attempts)), true
+}
+
+// exponentialDelay returns the base delay doubled for each attempt after the first one, capped by the max delay
+func (p backoffPolicy) exponentialDelay(attempts int) time.Duration {
+	delay := p.baseDelay
+	for i := 1; i < attempts && delay < math.MaxInt64/2 && (p.maxDelay <= 0 || delay < p.maxDelay); i++ {
+		delay *= 2
+	}
+
+	return p.cap(delay)
+}
+
+func (p backoffPolicy) cap(delay time.Duration) time.Duration {
+	if p.maxDelay > 0 && delay > p.maxDelay {
+		return p.maxDelay
+	}
+
+	return delay
+}
+
+// randomDelay returns a random delay in [0, upper]
+func (p backoffPolicy) randomDelay(upper time.Duration) time.Duration {
+	if upper <= 0 {
+		return 0
+	}
+
+	return time.Duration(p.random(int64(upper) + 1))
+}
@@ -0,0 +1,281 @@
+package dynamodbcopy_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/uniplaces/dynamodbcopy"
+	"github.com/uniplaces/dynamodbcopy/mocks"
+)
+
+func TestParseJitter(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		subTestName    string
+		value          string
+		expectedJitter dynamodbcopy.Jitter
+		errorExpected  bool
+	}{
+		{"Full", "full", dynamodbcopy.JitterFull, false},
+		{"Decorrelated", "decorrelated", dynamodbcopy.JitterDecorrelated, false},
+		{"Invalid", "equal", "", true},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(
+			testCase.subTestName,
+			func(st *testing.T) {
+				jitter, err := dynamodbcopy.ParseJitter(testCase.value)
+
+				assertExpectedError(st, testCase.errorExpected, err)
+				assert.Equal(st, testCase.expectedJitter, jitter)
+			},
+		)
+	}
+}
+
+func TestRetryPolicyLimits(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		subTestName   string
+		policy        dynamodbcopy.RetryPolicy
+		attempts      int
+		elapsed       time.Duration
+		expectedRetry bool
+	}{
+		{
+			"BelowMaxAttempts",
+			dynamodbcopy.NewRetryPolicy(3, 0, time.Millisecond, time.Second, dynamodbcopy.JitterFull),
+			2,
+			time.Hour,
+			true,
+		},
+		{
+			"MaxAttempts",
+			dynamodbcopy.NewRetryPolicy(3, 0, time.Millisecond, time.Second, dynamodbcopy.JitterFull),
+			3,
+			0,
+			false,
+		},
+		{
+			"BelowMaxElapsed",
+			dynamodbcopy.NewRetryPolicy(0, time.Minute, time.Millisecond, time.Second, dynamodbcopy.JitterDecorrelated),
+			100,
+			time.Second,
+			true,
+		},
+		{
+			"MaxElapsed",
+			dynamodbcopy.NewRetryPolicy(0, time.Minute, time.Millisecond, time.Second, dynamodbcopy.JitterDecorrelated),
+			1,
+			time.Minute,
+			false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(
+			testCase.subTestName,
+			func(st *testing.T) {
+				_, retry := testCase.policy.Delay(testCase.attempts, testCase.elapsed, 0)
+
+				assert.Equal(st, testCase.expectedRetry, retry)
+			},
+		)
+	}
+}
+
+func TestRetryPolicyFullJitter(t *testing.T) {
+	t.Parallel()
+
+	policy := dynamodbcopy.NewRetryPolicy(0, 0, 100*time.Millisecond, time.Second, dynamodbcopy.JitterFull)
+
+	maxDelays := map[int]time.Duration{
+		1:  100 * time.Millisecond,
+		2:  200 * time.Millisecond,
+		4:  800 * time.Millisecond,
+		5:  time.Second,
+		80: time.Second,
+	}
+
+	for attempts, maxDelay := range maxDelays {
+		for i := 0; i < 100; i++ {
+			delay, retry := policy.Delay(attempts, 0, 0)
+
+			require.True(t, retry)
+			require.True(t, delay >= 0 && delay <= maxDelay, "attempt %d delay %s above %s", attempts, delay, maxDelay)
+		}
+	}
+}
+
+func TestRetryPolicyDecorrelatedJitter(t *testing.T) {
+	t.Parallel()
+
+	base := 100 * time.Millisecond
+	policy := dynamodbcopy.NewRetryPolicy(0, 0, base, time.Second, dynamodbcopy.JitterDecorrelated)
+
+	delay, retry := policy.Delay(1, 0, 0)
+	require.True(t, retry)
+	assert.Equal(t, base, delay)
+
+	for _, previous := range []time.Duration{base, 200 * time.Millisecond, 900 * time.Millisecond} {
+		maxDelay := 3 * previous
+		if maxDelay > time.Second {
+			maxDelay = time.Second
+		}
+
+		for i := 0; i < 100; i++ {
+			delay, retry := policy.Delay(2, 0, previous)
+
+			require.True(t, retry)
+			require.True(t, delay >= base && delay <= maxDelay, "delay %s out of [%s, %s]", delay, base, maxDelay)
+		}
+	}
+}
+
+func TestRetryPolicyBatchWrite(t *testing.T) {
+	t.Parallel()
+
+	batchInput := buildBatchWriteItemInput(10)
+	throttleErr := awserr.New(dynamodb.ErrCodeProvisionedThroughputExceededException, "err", errors.New("throttled"))
+
+	api := &mocks.DynamoDBAPI{}
+	api.On("BatchWriteItem", &batchInput).Return(nil, throttleErr).Times(3)
+
+	var sleeps []int
+	sleeper := func(ms int) int {
+		sleeps = append(sleeps, ms)
+
+		return ms
+	}
+
+	service := dynamodbcopy.NewDynamoDBService(
+		expectedTableName,
+		api,
+		sleeper,
+		log.New(ioutil.Discard, "", log.Ltime),
+		dynamodbcopy.WithRetryPolicy(
+			dynamodbcopy.NewRetryPolicy(3, 0, 10*time.Millisecond, 20*time.Millisecond, dynamodbcopy.JitterFull),
+		),
+	)
+
+	err := service.BatchWrite(getItems(batchInput))
+
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "gave up after 3 attempts")
+	require.Len(t, sleeps, 2)
+	assert.True(t, sleeps[0] <= 10)
+	assert.True(t, sleeps[1] <= 20)
+
+	api.AssertExpectations(t)
+}
+
+func TestRetryPolicyScanPages(t *testing.T) {
+	t.Parallel()
+
+	throttleErr := awserr.New(dynamodb.ErrCodeProvisionedThroughputExceededException, "err", errors.New("throttled"))
+	item := map[string]*dynamodb.AttributeValue{"id": {S: aws.String("item")}}
+
+	// each request receives a page before being throttled, more times than the attempts of the retry policy
+	throttledPages := 5
+	api := &mocks.DynamoDBAPI{}
+	api.On("ScanPages", mock.AnythingOfType("*dynamodb.ScanInput"), mock.Anything).
+		Run(func(args mock.Arguments) {
+			pager := args.Get(1).(func(*dynamodb.ScanOutput, bool) bool)
+			pager(&dynamodb.ScanOutput{Items: []map[string]*dynamodb.AttributeValue{item}, LastEvaluatedKey: item}, false)
+		}).
+		Return(throttleErr).
+		Times(throttledPages)
+	api.On("ScanPages", mock.AnythingOfType("*dynamodb.ScanInput"), mock.Anything).
+		Run(func(args mock.Arguments) {
+			pager := args.Get(1).(func(*dynamodb.ScanOutput, bool) bool)
+			pager(&dynamodb.ScanOutput{Items: []map[string]*dynamodb.AttributeValue{item}}, true)
+		}).
+		Return(nil).
+		Once()
+
+	service := dynamodbcopy.NewDynamoDBService(
+		expectedTableName,
+		api,
+		testSleeper,
+		log.New(ioutil.Discard, "", log.Ltime),
+		dynamodbcopy.WithRetryPolicy(
+			dynamodbcopy.NewRetryPolicy(2, 0, 10*time.Millisecond, 20*time.Millisecond, dynamodbcopy.JitterFull),
+		),
+	)
+
+	itemsChan := make(chan []dynamodbcopy.DynamoDBItem, throttledPages+1)
+	err := service.Scan(1, 0, itemsChan)
+
+	require.Nil(t, err)
+	assert.Len(t, itemsChan, throttledPages+1)
+
+	api.AssertExpectations(t)
+}
+
+func TestTableWaitPolicy(t *testing.T) {
+	t.Parallel()
+
+	descriptionMock := mock.AnythingOfType("*dynamodb.DescribeTableInput")
+	creatingDescribeOutput := buildDescribeTableOutput(expectedTableName, dynamodb.TableStatusCreating)
+	activeDescribeOutput := buildDescribeTableOutput(expectedTableName, dynamodb.TableStatusActive)
+
+	// the request retry policy doesn't allow any retry, so only the table wait policy lets the wait go on
+	requestPolicy := dynamodbcopy.NewRetryPolicy(
+		1,
+		time.Millisecond,
+		10*time.Millisecond,
+		20*time.Millisecond,
+		dynamodbcopy.JitterFull,
+	)
+
+	api := &mocks.DynamoDBAPI{}
+	api.On("DescribeTable", descriptionMock).Return(creatingDescribeOutput, nil).Times(3)
+	api.On("DescribeTable", descriptionMock).Return(activeDescribeOutput, nil).Once()
+
+	service := dynamodbcopy.NewDynamoDBService(
+		expectedTableName,
+		api,
+		testSleeper,
+		log.New(ioutil.Discard, "", log.Ltime),
+		dynamodbcopy.WithRetryPolicy(requestPolicy),
+	)
+
+	require.Nil(t, service.WaitForReadyTable())
+
+	api.AssertExpectations(t)
+
+	api = &mocks.DynamoDBAPI{}
+	api.On("DescribeTable", descriptionMock).Return(creatingDescribeOutput, nil).Times(2)
+
+	service = dynamodbcopy.NewDynamoDBService(
+		expectedTableName,
+		api,
+		testSleeper,
+		log.New(ioutil.Discard, "", log.Ltime),
+		dynamodbcopy.WithRetryPolicy(requestPolicy),
+		dynamodbcopy.WithTableWaitPolicy(
+			dynamodbcopy.NewRetryPolicy(2, 0, 10*time.Millisecond, 20*time.Millisecond, dynamodbcopy.JitterFull),
+		),
+	)
+
+	err := service.WaitForReadyTable()
+
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "gave up after 2 attempts")
+
+	api.AssertExpectations(t)
+}
@@ -0,0 +1,302 @@
+package dynamodbcopy
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+const noValue = "<none>"
+
+// SchemaDiffer is the interface that allows you to compare the schemas and settings of the source and target tables
+type SchemaDiffer interface {
+	Diff() (SchemaDiff, error)
+}
+
+type schemaDiffService struct {
+	srcTable DynamoDBService
+	trgTable DynamoDBService
+}
+
+// NewSchemaDiffer returns a new SchemaDiffer of the given tables
+func NewSchemaDiffer(srcTableService, trgTableService DynamoDBService) SchemaDiffer {
+	return schemaDiffService{
+		srcTable: srcTableService,
+		trgTable: trgTableService,
+	}
+}
+
+// SchemaDifference is a difference between a schema element or setting of the source and target tables.
+// A difference is Incompatible when it makes writing the source items into the target table fail
+type SchemaDifference struct {
+	Field        string
+	Source       string
+	Target       string
+	Incompatible bool
+}
+
+// SchemaDiff holds the differences between the source and target tables
+type SchemaDiff []SchemaDifference
+
+// Incompatibilities returns the number of incompatible differences
+func (d SchemaDiff) Incompatibilities() int {
+	count := 0
+	for _, difference := range d {
+		if difference.Incompatible {
+			count++
+		}
+	}
+
+	return count
+}
+
+// Print logs the differences, incompatible ones first
+func (d SchemaDiff) Print(logger Logger) {
+	if len(d) == 0 {
+		logger.Printf("the schemas and settings of both tables match")
+
+		return
+	}
+
+	for _, incompatible := range []bool{true, false} {
+		for _, difference := range d {
+			if difference.Incompatible != incompatible {
+				continue
+			}
+
+			kind := "cosmetic"
+			if incompatible {
+				kind = "incompatible"
+			}
+			logger.Printf("%s: %s: source %s, target %s", kind, difference.Field, difference.Source, difference.Target)
+		}
+	}
+}
+
+type tableSchema struct {
+	description         *dynamodb.TableDescription
+	timeToLive          *dynamodb.TimeToLiveDescription
+	pointInTimeRecovery bool
+	tags                map[string]string
+}
+
+func describeSchema(table DynamoDBService) (tableSchema, error) {
+	description, err := table.DescribeTable()
+	if err != nil {
+		return tableSchema{}, err
+	}
+
+	timeToLive, err := table.TimeToLive()
+	if err != nil {
+		return tableSchema{}, err
+	}
+
+	pointInTimeRecovery, err := table.PointInTimeRecovery()
+	if err != nil {
+		return tableSchema{}, err
+	}
+
+	tags, err := table.Tags()
+	if err != nil {
+		return tableSchema{}, err
+	}
+
+	return tableSchema{description, timeToLive, pointInTimeRecovery, tags}, nil
+}
+
+// Diff compares the key schemas, attribute definitions, secondary indexes, billing modes, time to live, streams,
+// encryption, point in time recovery and tags of the tables.
+//
+// Different key schemas, target attribute definitions missing or with a different type in the source and target
+// secondary indexes missing or keyed differently in the source make the items of the source table incompatible with
+// the target, as writing a source item with an index key attribute of another type fails
+func (service schemaDiffService) Diff() (SchemaDiff, error) {
+	src, err := describeSchema(service.srcTable)
+	if err != nil {
+		return nil, err
+	}
+
+	trg, err := describeSchema(service.trgTable)
+	if err != nil {
+		return nil, err
+	}
+
+	var diff SchemaDiff
+	add := func(field, srcValue, trgValue string, incompatible bool) {
+		if srcValue != trgValue {
+			diff = append(diff, SchemaDifference{field, srcValue, trgValue, incompatible})
+		}
+	}
+
+	add(
+		"key schema",
+		strings.Join(keyAttributes(src.description), ", "),
+		strings.Join(keyAttributes(trg.description), ", "),
+		true,
+	)
+
+	srcTypes, trgTypes := attributeTypes(src.description), attributeTypes(trg.description)
+	for _, name := range unionKeys(srcTypes, trgTypes) {
+		srcType, trgType := valueOrNone(srcTypes, name), valueOrNone(trgTypes, name)
+		add("attribute "+name, srcType, trgType, trgType != noValue)
+	}
+
+	srcIndexes, srcIndexKeys := globalIndexes(src.description)
+	trgIndexes, trgIndexKeys := globalIndexes(trg.description)
+	for _, name := range unionKeys(srcIndexes, trgIndexes) {
+		add(
+			"global secondary index "+name,
+			valueOrNone(srcIndexes, name),
+			valueOrNone(trgIndexes, name),
+			incompatibleIndex(srcIndexKeys, trgIndexKeys, name),
+		)
+	}
+
+	srcIndexes, srcIndexKeys = localIndexes(src.description)
+	trgIndexes, trgIndexKeys = localIndexes(trg.description)
+	for _, name := range unionKeys(srcIndexes, trgIndexes) {
+		add(
+			"local secondary index "+name,
+			valueOrNone(srcIndexes, name),
+			valueOrNone(trgIndexes, name),
+			incompatibleIndex(srcIndexKeys, trgIndexKeys, name),
+		)
+	}
+
+	add("billing mode", billingMode(src.description), billingMode(trg.description), false)
+	add("time to live", formatTimeToLive(src.timeToLive), formatTimeToLive(trg.timeToLive), false)
+	add("stream", formatStream(src.description), formatStream(trg.description), false)
+	add("server side encryption", formatSSE(src.description), formatSSE(trg.description), false)
+	add("point in time recovery", formatEnabled(src.pointInTimeRecovery), formatEnabled(trg.pointInTimeRecovery), false)
+	add("tags", formatTags(src.tags), formatTags(trg.tags), false)
+
+	return diff, nil
+}
+
+// globalIndexes returns the formatted global secondary indexes of a table and their formatted key schemas by name
+func globalIndexes(description *dynamodb.TableDescription) (map[string]string, map[string]string) {
+	types := attributeTypes(description)
+
+	indexes := make(map[string]string, len(description.GlobalSecondaryIndexes))
+	keys := make(map[string]string, len(description.GlobalSecondaryIndexes))
+	for _, index := range description.GlobalSecondaryIndexes {
+		name := aws.StringValue(index.IndexName)
+		indexes[name] = formatIndex(index.KeySchema, index.Projection, types)
+		keys[name] = strings.Join(keySchemaAttributes(index.KeySchema, types), ", ")
+	}
+
+	return indexes, keys
+}
+
+// localIndexes returns the formatted local secondary indexes of a table and their formatted key schemas by name
+func localIndexes(description *dynamodb.TableDescription) (map[string]string, map[string]string) {
+	types := attributeTypes(description)
+
+	indexes := make(map[string]string, len(description.LocalSecondaryIndexes))
+	keys := make(map[string]string, len(description.LocalSecondaryIndexes))
+	for _, index := range description.LocalSecondaryIndexes {
+		name := aws.StringValue(index.IndexName)
+		indexes[name] = formatIndex(index.KeySchema, index.Projection, types)
+		keys[name] = strings.Join(keySchemaAttributes(index.KeySchema, types), ", ")
+	}
+
+	return indexes, keys
+}
+
+// incompatibleIndex reports whether the target index is missing in the source or keyed differently.
+// Source only indexes and projection differences are cosmetic
+func incompatibleIndex(srcKeys, trgKeys map[string]string, name string) bool {
+	trgKey, ok := trgKeys[name]
+
+	return ok && valueOrNone(srcKeys, name) != trgKey
+}
+
+func formatIndex(schema []*dynamodb.KeySchemaElement, projection *dynamodb.Projection, types map[string]string) string {
+	keys := strings.Join(keySchemaAttributes(schema, types), ", ")
+	if projection == nil {
+		return keys
+	}
+
+	projected := aws.StringValue(projection.ProjectionType)
+	if len(projection.NonKeyAttributes) > 0 {
+		attributes := aws.StringValueSlice(projection.NonKeyAttributes)
+		sort.Strings(attributes)
+		projected = fmt.Sprintf("%s %v", projected, attributes)
+	}
+
+	return fmt.Sprintf("%s, projection %s", keys, projected)
+}
+
+func formatTimeToLive(timeToLive *dynamodb.TimeToLiveDescription) string {
+	if timeToLive == nil || timeToLive.AttributeName == nil {
+		return dynamodb.TimeToLiveStatusDisabled
+	}
+
+	return fmt.Sprintf("%s (%s)", aws.StringValue(timeToLive.TimeToLiveStatus), aws.StringValue(timeToLive.AttributeName))
+}
+
+func formatStream(description *dynamodb.TableDescription) string {
+	stream := description.StreamSpecification
+	if stream == nil || !aws.BoolValue(stream.StreamEnabled) {
+		return formatEnabled(false)
+	}
+
+	return fmt.Sprintf("%s (%s)", formatEnabled(true), aws.StringValue(stream.StreamViewType))
+}
+
+func formatSSE(description *dynamodb.TableDescription) string {
+	sse := description.SSEDescription
+	if sse == nil {
+		return "DEFAULT"
+	}
+
+	return fmt.Sprintf("%s (%s)", aws.StringValue(sse.Status), aws.StringValue(sse.SSEType))
+}
+
+func formatEnabled(enabled bool) string {
+	if enabled {
+		return "ENABLED"
+	}
+
+	return "DISABLED"
+}
+
+func formatTags(tags map[string]string) string {
+	if len(tags) == 0 {
+		return noValue
+	}
+
+	parts := make([]string, 0, len(tags))
+	for key, value := range tags {
+		parts = append(parts, fmt.Sprintf("%s=%s", key, value))
+	}
+	sort.Strings(parts)
+
+	return strings.Join(parts, ", ")
+}
+
+func valueOrNone(values map[string]string, key string) string {
+	if value, ok := values[key]; ok {
+		return value
+	}
+
+	return noValue
+}
+
+func unionKeys(a, b map[string]string) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for key := range a {
+		keys = append(keys, key)
+	}
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,216 @@
+package dynamodbcopy_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/uniplaces/dynamodbcopy"
+	"github.com/uniplaces/dynamodbcopy/mocks"
+)
+
+func TestSchemaDiff(t *testing.T) {
+	t.Parallel()
+
+	expectedError := errors.New("diff error")
+
+	testCases := []struct {
+		subTestName   string
+		mocker        func(src, trg *mocks.DynamoDBService)
+		expectedDiff  dynamodbcopy.SchemaDiff
+		expectedError bool
+	}{
+		{
+			"DescribeError",
+			func(src, trg *mocks.DynamoDBService) {
+				src.On("DescribeTable").Return(nil, expectedError).Once()
+			},
+			nil,
+			true,
+		},
+		{
+			"TimeToLiveError",
+			func(src, trg *mocks.DynamoDBService) {
+				description := buildKeyedTableDescription(srcTableName, "id", dynamodb.ScalarAttributeTypeS)
+				src.On("DescribeTable").Return(&description, nil).Once()
+				src.On("TimeToLive").Return(nil, expectedError).Once()
+			},
+			nil,
+			true,
+		},
+		{
+			"NoDifferences",
+			func(src, trg *mocks.DynamoDBService) {
+				mockSchema(src, buildKeyedTableDescription(srcTableName, "id", dynamodb.ScalarAttributeTypeS), false)
+				mockSchema(trg, buildKeyedTableDescription(trgTableName, "id", dynamodb.ScalarAttributeTypeS), false)
+			},
+			nil,
+			false,
+		},
+		{
+			"Incompatible",
+			func(src, trg *mocks.DynamoDBService) {
+				mockSchema(src, buildKeyedTableDescription(srcTableName, "id", dynamodb.ScalarAttributeTypeS), false)
+				mockSchema(trg, buildKeyedTableDescription(trgTableName, "id", dynamodb.ScalarAttributeTypeN), false)
+			},
+			dynamodbcopy.SchemaDiff{
+				{Field: "key schema", Source: "HASH id (S)", Target: "HASH id (N)", Incompatible: true},
+				{Field: "attribute id", Source: "S", Target: "N", Incompatible: true},
+			},
+			false,
+		},
+		{
+			"IncompatibleIndexes",
+			func(src, trg *mocks.DynamoDBService) {
+				srcDescription := buildKeyedTableDescription(srcTableName, "id", dynamodb.ScalarAttributeTypeS)
+				srcDescription.AttributeDefinitions = append(
+					srcDescription.AttributeDefinitions,
+					&dynamodb.AttributeDefinition{AttributeName: aws.String("email"), AttributeType: aws.String("S")},
+				)
+				srcDescription.GlobalSecondaryIndexes = []*dynamodb.GlobalSecondaryIndexDescription{
+					{
+						IndexName: aws.String("email-index"),
+						KeySchema: []*dynamodb.KeySchemaElement{
+							{AttributeName: aws.String("email"), KeyType: aws.String(dynamodb.KeyTypeHash)},
+						},
+					},
+				}
+
+				trgDescription := buildKeyedTableDescription(trgTableName, "id", dynamodb.ScalarAttributeTypeS)
+				trgDescription.AttributeDefinitions = append(
+					trgDescription.AttributeDefinitions,
+					&dynamodb.AttributeDefinition{AttributeName: aws.String("email"), AttributeType: aws.String("N")},
+					&dynamodb.AttributeDefinition{AttributeName: aws.String("created"), AttributeType: aws.String("N")},
+				)
+				trgDescription.GlobalSecondaryIndexes = []*dynamodb.GlobalSecondaryIndexDescription{
+					{
+						IndexName: aws.String("email-index"),
+						KeySchema: []*dynamodb.KeySchemaElement{
+							{AttributeName: aws.String("email"), KeyType: aws.String(dynamodb.KeyTypeHash)},
+						},
+					},
+				}
+				trgDescription.LocalSecondaryIndexes = []*dynamodb.LocalSecondaryIndexDescription{
+					{
+						IndexName: aws.String("created-index"),
+						KeySchema: []*dynamodb.KeySchemaElement{
+							{AttributeName: aws.String("id"), KeyType: aws.String(dynamodb.KeyTypeHash)},
+							{AttributeName: aws.String("created"), KeyType: aws.String(dynamodb.KeyTypeRange)},
+						},
+					},
+				}
+
+				mockSchema(src, srcDescription, false)
+				mockSchema(trg, trgDescription, false)
+			},
+			dynamodbcopy.SchemaDiff{
+				{Field: "attribute created", Source: "<none>", Target: "N", Incompatible: true},
+				{Field: "attribute email", Source: "S", Target: "N", Incompatible: true},
+				{
+					Field:        "global secondary index email-index",
+					Source:       "HASH email (S)",
+					Target:       "HASH email (N)",
+					Incompatible: true,
+				},
+				{
+					Field:        "local secondary index created-index",
+					Source:       "<none>",
+					Target:       "HASH id (S), RANGE created (N)",
+					Incompatible: true,
+				},
+			},
+			false,
+		},
+		{
+			"Cosmetic",
+			func(src, trg *mocks.DynamoDBService) {
+				srcDescription := buildKeyedTableDescription(srcTableName, "id", dynamodb.ScalarAttributeTypeS)
+				srcDescription.StreamSpecification = &dynamodb.StreamSpecification{
+					StreamEnabled:  aws.Bool(true),
+					StreamViewType: aws.String(dynamodb.StreamViewTypeNewImage),
+				}
+				srcDescription.AttributeDefinitions = append(
+					srcDescription.AttributeDefinitions,
+					&dynamodb.AttributeDefinition{AttributeName: aws.String("email"), AttributeType: aws.String("S")},
+				)
+				srcDescription.GlobalSecondaryIndexes = []*dynamodb.GlobalSecondaryIndexDescription{
+					{
+						IndexName: aws.String("email-index"),
+						KeySchema: []*dynamodb.KeySchemaElement{
+							{AttributeName: aws.String("email"), KeyType: aws.String(dynamodb.KeyTypeHash)},
+						},
+						Projection: &dynamodb.Projection{ProjectionType: aws.String(dynamodb.ProjectionTypeKeysOnly)},
+					},
+				}
+				mockSchema(src, srcDescription, true)
+				mockSchema(trg, buildKeyedTableDescription(trgTableName, "id", dynamodb.ScalarAttributeTypeS), false)
+			},
+			dynamodbcopy.SchemaDiff{
+				{Field: "attribute email", Source: "S", Target: "<none>"},
+				{
+					Field:  "global secondary index email-index",
+					Source: "HASH email (S), projection KEYS_ONLY",
+					Target: "<none>",
+				},
+				{Field: "time to live", Source: "ENABLED (expiresAt)", Target: "DISABLED"},
+				{Field: "stream", Source: "ENABLED (NEW_IMAGE)", Target: "DISABLED"},
+				{Field: "point in time recovery", Source: "ENABLED", Target: "DISABLED"},
+				{Field: "tags", Source: "team=core", Target: "<none>"},
+			},
+			false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.subTestName,
+			func(st *testing.T) {
+				src, trg := &mocks.DynamoDBService{}, &mocks.DynamoDBService{}
+				testCase.mocker(src, trg)
+
+				diff, err := dynamodbcopy.NewSchemaDiffer(src, trg).Diff()
+
+				assert.Equal(st, testCase.expectedError, err != nil)
+				assert.Equal(st, testCase.expectedDiff, diff)
+
+				src.AssertExpectations(st)
+				trg.AssertExpectations(st)
+			},
+		)
+	}
+}
+
+func TestSchemaDiffIncompatibilities(t *testing.T) {
+	t.Parallel()
+
+	diff := dynamodbcopy.SchemaDiff{
+		{Field: "key schema", Source: "HASH id (S)", Target: "HASH id (N)", Incompatible: true},
+		{Field: "tags", Source: "team=core", Target: "<none>"},
+	}
+
+	assert.Equal(t, 1, diff.Incompatibilities())
+	assert.Equal(t, 0, dynamodbcopy.SchemaDiff{}.Incompatibilities())
+
+	diff.Print(log.New(ioutil.Discard, "", log.LstdFlags))
+}
+
+func mockSchema(service *mocks.DynamoDBService, description dynamodb.TableDescription, settings bool) {
+	timeToLive := &dynamodb.TimeToLiveDescription{TimeToLiveStatus: aws.String(dynamodb.TimeToLiveStatusDisabled)}
+	tags := map[string]string{}
+	if settings {
+		timeToLive = &dynamodb.TimeToLiveDescription{
+			AttributeName:    aws.String("expiresAt"),
+			TimeToLiveStatus: aws.String(dynamodb.TimeToLiveStatusEnabled),
+		}
+		tags["team"] = "core"
+	}
+
+	service.On("DescribeTable").Return(&description, nil).Once()
+	service.On("TimeToLive").Return(timeToLive, nil).Once()
+	service.On("PointInTimeRecovery").Return(settings, nil).Once()
+	service.On("Tags").Return(tags, nil).Once()
+}
@@ -0,0 +1,199 @@
+package dynamodbcopy
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+// SettingsCopier is the interface that allows you to copy the table-level settings of the source to the target table
+type SettingsCopier interface {
+	CopySettings() error
+}
+
+type settingsService struct {
+	srcTable DynamoDBService
+	trgTable DynamoDBService
+	logger   Logger
+}
+
+// NewSettingsCopier returns a new SettingsCopier of the given tables
+func NewSettingsCopier(srcTableService, trgTableService DynamoDBService, logger Logger) SettingsCopier {
+	return settingsService{
+		srcTable: srcTableService,
+		trgTable: trgTableService,
+		logger:   logger,
+	}
+}
+
+// CopySettings applies the time to live, point in time recovery, stream, deletion protection, contributor insights
+// and tags of the source table to the target table, only updating the settings that differ.
+//
+// The tags of the source table are added to the target ones, without removing the tags only the target table has
+func (service settingsService) CopySettings() error {
+	if err := service.copyTimeToLive(); err != nil {
+		return err
+	}
+
+	if err := service.copyPointInTimeRecovery(); err != nil {
+		return err
+	}
+
+	src, err := service.srcTable.DescribeTable()
+	if err != nil {
+		return err
+	}
+
+	trg, err := service.trgTable.DescribeTable()
+	if err != nil {
+		return err
+	}
+
+	if err := service.copyStream(src, trg); err != nil {
+		return err
+	}
+
+	if err := service.copyDeletionProtection(src, trg); err != nil {
+		return err
+	}
+
+	if err := service.copyContributorInsights(); err != nil {
+		return err
+	}
+
+	return service.copyTags()
+}
+
+func (service settingsService) copyTimeToLive() error {
+	src, err := service.srcTable.TimeToLive()
+	if err != nil {
+		return err
+	}
+
+	trg, err := service.trgTable.TimeToLive()
+	if err != nil {
+		return err
+	}
+
+	srcAttribute, trgAttribute := timeToLiveAttribute(src), timeToLiveAttribute(trg)
+	switch {
+	case srcAttribute == trgAttribute:
+		return nil
+	case trgAttribute == "":
+		return service.trgTable.UpdateTimeToLive(srcAttribute, true)
+	case srcAttribute == "":
+		return service.trgTable.UpdateTimeToLive(trgAttribute, false)
+	default:
+		// the time to live can only be updated once in a while, so it can't be disabled and enabled right away
+		return fmt.Errorf(
+			"unable to copy time to live on %s: target table has it enabled on %s, disable it first",
+			srcAttribute,
+			trgAttribute,
+		)
+	}
+}
+
+// timeToLiveAttribute returns the attribute of an enabled (or enabling) time to live, or an empty string otherwise
+func timeToLiveAttribute(timeToLive *dynamodb.TimeToLiveDescription) string {
+	if timeToLive == nil {
+		return ""
+	}
+
+	switch aws.StringValue(timeToLive.TimeToLiveStatus) {
+	case dynamodb.TimeToLiveStatusEnabled, dynamodb.TimeToLiveStatusEnabling:
+		return aws.StringValue(timeToLive.AttributeName)
+	default:
+		return ""
+	}
+}
+
+func (service settingsService) copyPointInTimeRecovery() error {
+	src, err := service.srcTable.PointInTimeRecovery()
+	if err != nil {
+		return err
+	}
+
+	trg, err := service.trgTable.PointInTimeRecovery()
+	if err != nil {
+		return err
+	}
+
+	if src == trg {
+		return nil
+	}
+
+	return service.trgTable.UpdatePointInTimeRecovery(src)
+}
+
+func (service settingsService) copyStream(src, trg *dynamodb.TableDescription) error {
+	srcStream, trgStream := formatStream(src), formatStream(trg)
+	if srcStream == trgStream {
+		return nil
+	}
+
+	// a stream has to be disabled before being enabled with another view type
+	if trgStream != formatEnabled(false) {
+		if err := service.trgTable.UpdateStream(&dynamodb.StreamSpecification{StreamEnabled: aws.Bool(false)}); err != nil {
+			return err
+		}
+	}
+
+	if srcStream == formatEnabled(false) {
+		return nil
+	}
+
+	return service.trgTable.UpdateStream(src.StreamSpecification)
+}
+
+func (service settingsService) copyDeletionProtection(src, trg *dynamodb.TableDescription) error {
+	enabled := aws.BoolValue(src.DeletionProtectionEnabled)
+	if enabled == aws.BoolValue(trg.DeletionProtectionEnabled) {
+		return nil
+	}
+
+	return service.trgTable.UpdateDeletionProtection(enabled)
+}
+
+func (service settingsService) copyContributorInsights() error {
+	src, err := service.srcTable.ContributorInsights()
+	if err != nil {
+		return err
+	}
+
+	trg, err := service.trgTable.ContributorInsights()
+	if err != nil {
+		return err
+	}
+
+	if src == trg {
+		return nil
+	}
+
+	return service.trgTable.UpdateContributorInsights(src)
+}
+
+func (service settingsService) copyTags() error {
+	src, err := service.srcTable.Tags()
+	if err != nil {
+		return err
+	}
+
+	trg, err := service.trgTable.Tags()
+	if err != nil {
+		return err
+	}
+
+	tags := make(map[string]string)
+	for key, value := range src {
+		if trgValue, ok := trg[key]; !ok || trgValue != value {
+			tags[key] = value
+		}
+	}
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	return service.trgTable.TagTable(tags)
+}
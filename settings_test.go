@@ -0,0 +1,163 @@
+package dynamodbcopy_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/uniplaces/dynamodbcopy"
+	"github.com/uniplaces/dynamodbcopy/mocks"
+)
+
+func TestCopySettings(t *testing.T) {
+	t.Parallel()
+
+	expectedError := errors.New("copy settings error")
+	enabledTimeToLive := buildTimeToLive("expiresAt", dynamodb.TimeToLiveStatusEnabled)
+	disabledTimeToLive := &dynamodb.TimeToLiveDescription{TimeToLiveStatus: aws.String(dynamodb.TimeToLiveStatusDisabled)}
+	newImageStream := &dynamodb.StreamSpecification{
+		StreamEnabled:  aws.Bool(true),
+		StreamViewType: aws.String(dynamodb.StreamViewTypeNewImage),
+	}
+	keysOnlyStream := &dynamodb.StreamSpecification{
+		StreamEnabled:  aws.Bool(true),
+		StreamViewType: aws.String(dynamodb.StreamViewTypeKeysOnly),
+	}
+	disabledStream := &dynamodb.StreamSpecification{StreamEnabled: aws.Bool(false)}
+
+	testCases := []struct {
+		subTestName   string
+		mocker        func(src, trg *mocks.DynamoDBService)
+		expectedError bool
+	}{
+		{
+			"TimeToLiveError",
+			func(src, trg *mocks.DynamoDBService) {
+				src.On("TimeToLive").Return(nil, expectedError).Once()
+			},
+			true,
+		},
+		{
+			"TimeToLiveOnAnotherAttribute",
+			func(src, trg *mocks.DynamoDBService) {
+				src.On("TimeToLive").Return(enabledTimeToLive, nil).Once()
+				trg.On("TimeToLive").Return(buildTimeToLive("ttl", dynamodb.TimeToLiveStatusEnabled), nil).Once()
+			},
+			true,
+		},
+		{
+			"UpdateError",
+			func(src, trg *mocks.DynamoDBService) {
+				src.On("TimeToLive").Return(enabledTimeToLive, nil).Once()
+				trg.On("TimeToLive").Return(disabledTimeToLive, nil).Once()
+				trg.On("UpdateTimeToLive", "expiresAt", true).Return(expectedError).Once()
+			},
+			true,
+		},
+		{
+			"ContributorInsightsError",
+			func(src, trg *mocks.DynamoDBService) {
+				description := buildDefaultTableDescription(expectedTableName)
+				src.On("TimeToLive").Return(enabledTimeToLive, nil).Once()
+				trg.On("TimeToLive").Return(enabledTimeToLive, nil).Once()
+				src.On("PointInTimeRecovery").Return(true, nil).Once()
+				trg.On("PointInTimeRecovery").Return(true, nil).Once()
+				src.On("DescribeTable").Return(&description, nil).Once()
+				trg.On("DescribeTable").Return(&description, nil).Once()
+				src.On("ContributorInsights").Return(false, expectedError).Once()
+			},
+			true,
+		},
+		{
+			"NoDifferences",
+			func(src, trg *mocks.DynamoDBService) {
+				mockSettings(src, enabledTimeToLive, true, newImageStream, true, true, map[string]string{"team": "data"})
+				mockSettings(trg, enabledTimeToLive, true, newImageStream, true, true, map[string]string{"team": "data"})
+			},
+			false,
+		},
+		{
+			"Enable",
+			func(src, trg *mocks.DynamoDBService) {
+				mockSettings(src, enabledTimeToLive, true, newImageStream, true, true, map[string]string{"team": "data"})
+				mockSettings(trg, disabledTimeToLive, false, nil, false, false, map[string]string{"env": "staging"})
+				trg.On("UpdateTimeToLive", "expiresAt", true).Return(nil).Once()
+				trg.On("UpdatePointInTimeRecovery", true).Return(nil).Once()
+				trg.On("UpdateStream", newImageStream).Return(nil).Once()
+				trg.On("UpdateDeletionProtection", true).Return(nil).Once()
+				trg.On("UpdateContributorInsights", true).Return(nil).Once()
+				trg.On("TagTable", map[string]string{"team": "data"}).Return(nil).Once()
+			},
+			false,
+		},
+		{
+			"ChangeStreamViewType",
+			func(src, trg *mocks.DynamoDBService) {
+				mockSettings(src, disabledTimeToLive, false, newImageStream, false, false, nil)
+				mockSettings(trg, disabledTimeToLive, false, keysOnlyStream, false, false, nil)
+				trg.On("UpdateStream", disabledStream).Return(nil).Once()
+				trg.On("UpdateStream", newImageStream).Return(nil).Once()
+			},
+			false,
+		},
+		{
+			"Disable",
+			func(src, trg *mocks.DynamoDBService) {
+				mockSettings(src, disabledTimeToLive, false, nil, false, false, nil)
+				mockSettings(trg, enabledTimeToLive, true, keysOnlyStream, true, true, map[string]string{"team": "data"})
+				trg.On("UpdateTimeToLive", "expiresAt", false).Return(nil).Once()
+				trg.On("UpdatePointInTimeRecovery", false).Return(nil).Once()
+				trg.On("UpdateStream", disabledStream).Return(nil).Once()
+				trg.On("UpdateDeletionProtection", false).Return(nil).Once()
+				trg.On("UpdateContributorInsights", false).Return(nil).Once()
+			},
+			false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.subTestName,
+			func(st *testing.T) {
+				src, trg := &mocks.DynamoDBService{}, &mocks.DynamoDBService{}
+				testCase.mocker(src, trg)
+
+				settings := dynamodbcopy.NewSettingsCopier(src, trg, log.New(ioutil.Discard, "", log.LstdFlags))
+				err := settings.CopySettings()
+
+				assert.Equal(st, testCase.expectedError, err != nil)
+
+				src.AssertExpectations(st)
+				trg.AssertExpectations(st)
+			},
+		)
+	}
+}
+
+func mockSettings(
+	service *mocks.DynamoDBService,
+	timeToLive *dynamodb.TimeToLiveDescription,
+	pointInTimeRecovery bool,
+	stream *dynamodb.StreamSpecification,
+	deletionProtection bool,
+	contributorInsights bool,
+	tags map[string]string,
+) {
+	description := buildDefaultTableDescription(expectedTableName)
+	description.StreamSpecification = stream
+	description.DeletionProtectionEnabled = aws.Bool(deletionProtection)
+
+	service.On("TimeToLive").Return(timeToLive, nil).Once()
+	service.On("PointInTimeRecovery").Return(pointInTimeRecovery, nil).Once()
+	service.On("DescribeTable").Return(&description, nil).Once()
+	service.On("ContributorInsights").Return(contributorInsights, nil).Once()
+	service.On("Tags").Return(tags, nil).Once()
+}
+
+func buildTimeToLive(attribute, status string) *dynamodb.TimeToLiveDescription {
+	return &dynamodb.TimeToLiveDescription{AttributeName: aws.String(attribute), TimeToLiveStatus: aws.String(status)}
+}
@@ -0,0 +1,194 @@
+package dynamodbcopy
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+// Auto sizing limits and estimates
+const (
+	// autoSegmentBytes is the size of the table scanned by each segment
+	autoSegmentBytes = 2 << 30
+	maxAutoSegments  = 1000
+
+	// scanPageBytes is the max size of a scan page, consuming 128 read units per second when read every second
+	scanPageBytes  = 1 << 20
+	readerUnits    = scanPageBytes / readUnitBytes / 2
+	maxAutoReaders = 32
+
+	// writerItems is the number of items written per second by each writer, 4 batches of 25 items,
+	// and minWriterItems the items a writer should have to write, at least
+	writerItems    = 100
+	minWriterItems = 1000
+	maxAutoWriters = 64
+
+	// bufferPagesPerWriter is the number of scanned pages buffered for each writer
+	bufferPagesPerWriter = 2
+	maxAutoBufferSize    = 64
+)
+
+// Sizing is the number of segments a table is scanned in, the number of read and write workers and the number of
+// scanned pages buffered between them in a copy
+type Sizing struct {
+	Segments   int
+	Readers    int
+	Writers    int
+	BufferSize int
+}
+
+// Sizer is the interface that allows you to pick the Sizing of a copy
+type Sizer interface {
+	Size(config Config) (Sizing, error)
+}
+
+type sizeService struct {
+	srcTable DynamoDBService
+	trgTable DynamoDBService
+	logger   Logger
+}
+
+// NewSizer returns a new Sizer that picks the Sizing of a copy between the given tables
+func NewSizer(srcTableService, trgTableService DynamoDBService, logger Logger) Sizer {
+	return sizeService{
+		srcTable: srcTableService,
+		trgTable: trgTableService,
+		logger:   logger,
+	}
+}
+
+// Size picks the Sizing of the copy from the size and item count of the source table and the capacity units the copy
+// can consume: the higher of the Config and the provisioned capacity units of the tables, or unlimited for on-demand
+// tables without capacity units in the Config.
+//
+// Each segment scans about 2 GB of the table, with enough readers to consume the read units scanning a 1 MB page
+// per second each, and enough writers to consume the write units writing 4 batches per second each, buffering 2
+// pages per writer. The reasoning behind the Sizing is logged
+func (service sizeService) Size(config Config) (Sizing, error) {
+	srcDescription, err := service.srcTable.DescribeTable()
+	if err != nil {
+		return Sizing{}, err
+	}
+
+	trgDescription, err := service.trgTable.DescribeTable()
+	if err != nil {
+		return Sizing{}, err
+	}
+
+	sizeBytes := aws.Int64Value(srcDescription.TableSizeBytes)
+	itemCount := aws.Int64Value(srcDescription.ItemCount)
+	readUnits := max64(config.readCapacityUnits, provisionedUnits(srcDescription, true))
+	writeUnits := max64(config.writeCapacityUnits, provisionedUnits(trgDescription, false))
+
+	itemBytes := int64(writeUnitBytes)
+	if itemCount > 0 && sizeBytes > 0 {
+		itemBytes = int64(math.Ceil(float64(sizeBytes) / float64(itemCount)))
+	}
+
+	service.logger.Printf(
+		"auto sizing the copy of %d items (%d bytes, %d bytes per item) with %s read units and %s write units",
+		itemCount,
+		sizeBytes,
+		itemBytes,
+		formatUnits(readUnits),
+		formatUnits(writeUnits),
+	)
+
+	var sizing Sizing
+	var readersReason string
+	pages := ceilDiv(sizeBytes, scanPageBytes)
+	if readUnits > 0 {
+		sizing.Readers = clamp(min64(ceilDiv(readUnits, readerUnits), pages), maxAutoReaders)
+		readersReason = fmt.Sprintf("%d read units per reader", readerUnits)
+	} else {
+		sizing.Readers = clamp(pages, maxAutoReaders)
+		readersReason = "unlimited read units"
+	}
+	service.logger.Printf(
+		"auto sizing %d readers: %s, at most one per %d byte page and %d readers",
+		sizing.Readers,
+		readersReason,
+		scanPageBytes,
+		maxAutoReaders,
+	)
+
+	sizing.Segments = clamp(ceilDiv(sizeBytes, autoSegmentBytes), maxAutoSegments)
+	if sizing.Segments < sizing.Readers {
+		sizing.Segments = sizing.Readers
+	}
+	service.logger.Printf(
+		"auto sizing %d segments: one per %d bytes, at least one per reader and at most %d segments",
+		sizing.Segments,
+		autoSegmentBytes,
+		maxAutoSegments,
+	)
+
+	var writersReason string
+	writers := ceilDiv(itemCount, minWriterItems)
+	if writeUnits > 0 {
+		itemUnits := ceilDiv(itemBytes, writeUnitBytes)
+		sizing.Writers = clamp(min64(ceilDiv(writeUnits, writerItems*itemUnits), writers), maxAutoWriters)
+		writersReason = fmt.Sprintf("%d write units per writer", writerItems*itemUnits)
+	} else {
+		sizing.Writers = clamp(writers, maxAutoWriters)
+		writersReason = "unlimited write units"
+	}
+	service.logger.Printf(
+		"auto sizing %d writers: %s, at most one per %d items and %d writers",
+		sizing.Writers,
+		writersReason,
+		minWriterItems,
+		maxAutoWriters,
+	)
+
+	sizing = sizing.WithWriters(sizing.Writers)
+	service.logger.Printf(
+		"auto sizing a buffer of %d pages: %d per writer, at most %d pages",
+		sizing.BufferSize,
+		bufferPagesPerWriter,
+		maxAutoBufferSize,
+	)
+
+	return sizing, nil
+}
+
+// WithWriters returns the Sizing with the given number of writers, buffering 2 pages per writer, at most 64 pages
+func (s Sizing) WithWriters(writers int) Sizing {
+	s.Writers = writers
+	s.BufferSize = clamp(int64(bufferPagesPerWriter*writers), maxAutoBufferSize)
+
+	return s
+}
+
+// provisionedUnits returns the provisioned read or write capacity units of a table, 0 for on-demand tables
+func provisionedUnits(description *dynamodb.TableDescription, read bool) int64 {
+	throughput := description.ProvisionedThroughput
+	if throughput == nil {
+		return 0
+	}
+
+	if read {
+		return aws.Int64Value(throughput.ReadCapacityUnits)
+	}
+
+	return aws.Int64Value(throughput.WriteCapacityUnits)
+}
+
+func formatUnits(units int64) string {
+	if units <= 0 {
+		return "unlimited"
+	}
+
+	return fmt.Sprint(units)
+}
+
+func ceilDiv(n, d int64) int64 {
+	return (n + d - 1) / d
+}
+
+// clamp returns the value limited by the upper limit, and at least 1
+func clamp(value, upper int64) int {
+	return int(max64(min64(value, upper), 1))
+}
@@ -0,0 +1,145 @@
+package dynamodbcopy_test
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/uniplaces/dynamodbcopy"
+	"github.com/uniplaces/dynamodbcopy/mocks"
+)
+
+func TestSize(t *testing.T) {
+	t.Parallel()
+
+	expectedError := errors.New("describe error")
+
+	testCases := []struct {
+		subTestName    string
+		srcDescription dynamodb.TableDescription
+		trgDescription dynamodb.TableDescription
+		srcErr         error
+		trgErr         error
+		config         dynamodbcopy.Config
+		expectedSizing dynamodbcopy.Sizing
+		expectedError  error
+	}{
+		{
+			"SrcDescribeError",
+			dynamodb.TableDescription{},
+			dynamodb.TableDescription{},
+			expectedError,
+			nil,
+			dynamodbcopy.NewConfig(0, 0, 0, 0),
+			dynamodbcopy.Sizing{},
+			expectedError,
+		},
+		{
+			"TrgDescribeError",
+			buildSizedTableDescription(dynamodb.BillingModeProvisioned, 5, 5, 1000, 2048000),
+			dynamodb.TableDescription{},
+			nil,
+			expectedError,
+			dynamodbcopy.NewConfig(0, 0, 0, 0),
+			dynamodbcopy.Sizing{},
+			expectedError,
+		},
+		{
+			"EmptyTable",
+			buildSizedTableDescription(dynamodb.BillingModePayPerRequest, 0, 0, 0, 0),
+			buildTableDescription(trgTableName, dynamodb.BillingModePayPerRequest, 0, 0),
+			nil,
+			nil,
+			dynamodbcopy.NewConfig(0, 0, 0, 0),
+			dynamodbcopy.Sizing{Segments: 1, Readers: 1, Writers: 1, BufferSize: 2},
+			nil,
+		},
+		{
+			"SmallProvisionedTable",
+			buildSizedTableDescription(dynamodb.BillingModeProvisioned, 5, 5, 1000, 2048000),
+			buildTableDescription(trgTableName, dynamodb.BillingModeProvisioned, 5, 5),
+			nil,
+			nil,
+			dynamodbcopy.NewConfig(0, 0, 0, 0),
+			dynamodbcopy.Sizing{Segments: 1, Readers: 1, Writers: 1, BufferSize: 2},
+			nil,
+		},
+		{
+			"LargeTableWithConfigCapacity",
+			buildSizedTableDescription(dynamodb.BillingModePayPerRequest, 0, 0, 100000000, 100<<30),
+			buildTableDescription(trgTableName, dynamodb.BillingModePayPerRequest, 0, 0),
+			nil,
+			nil,
+			dynamodbcopy.NewConfig(1000, 4000, 0, 0),
+			dynamodbcopy.Sizing{Segments: 50, Readers: 8, Writers: 20, BufferSize: 40},
+			nil,
+		},
+		{
+			"UnlimitedCapacity",
+			buildSizedTableDescription(dynamodb.BillingModePayPerRequest, 0, 0, 10000000, 10<<30),
+			buildTableDescription(trgTableName, dynamodb.BillingModePayPerRequest, 0, 0),
+			nil,
+			nil,
+			dynamodbcopy.NewConfig(0, 0, 0, 0),
+			dynamodbcopy.Sizing{Segments: 32, Readers: 32, Writers: 64, BufferSize: 64},
+			nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(
+			testCase.subTestName,
+			func(st *testing.T) {
+				srcService := &mocks.DynamoDBService{}
+				trgService := &mocks.DynamoDBService{}
+
+				if testCase.srcErr != nil {
+					srcService.On("DescribeTable").Return(nil, testCase.srcErr).Once()
+				} else {
+					srcService.On("DescribeTable").Return(&testCase.srcDescription, nil).Once()
+					if testCase.trgErr != nil {
+						trgService.On("DescribeTable").Return(nil, testCase.trgErr).Once()
+					} else {
+						trgService.On("DescribeTable").Return(&testCase.trgDescription, nil).Once()
+					}
+				}
+
+				buffer := &bytes.Buffer{}
+				sizer := dynamodbcopy.NewSizer(srcService, trgService, log.New(buffer, "", 0))
+
+				sizing, err := sizer.Size(testCase.config)
+
+				assert.Equal(st, testCase.expectedError, err)
+				assert.Equal(st, testCase.expectedSizing, sizing)
+				if err == nil {
+					assert.Contains(st, buffer.String(), "auto sizing")
+				}
+
+				srcService.AssertExpectations(st)
+				trgService.AssertExpectations(st)
+			},
+		)
+	}
+}
+
+func buildSizedTableDescription(billingMode string, r, w, itemCount, sizeBytes int64) dynamodb.TableDescription {
+	description := buildTableDescription(srcTableName, billingMode, r, w)
+	description.ItemCount = aws.Int64(itemCount)
+	description.TableSizeBytes = aws.Int64(sizeBytes)
+
+	return description
+}
+
+func TestSizingWithWriters(t *testing.T) {
+	t.Parallel()
+
+	sizing := dynamodbcopy.Sizing{Segments: 4, Readers: 2, Writers: 8, BufferSize: 16}
+
+	assert.Equal(t, dynamodbcopy.Sizing{Segments: 4, Readers: 2, Writers: 3, BufferSize: 6}, sizing.WithWriters(3))
+	assert.Equal(t, dynamodbcopy.Sizing{Segments: 4, Readers: 2, Writers: 100, BufferSize: 64}, sizing.WithWriters(100))
+}
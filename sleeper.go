@@ -20,3 +20,11 @@ func RandomSleeper(ms int) int {
 
 	return elapsed
 }
+
+// Sleep will sleep for exactly the provided time (in ms), leaving the randomization of the delays to the
+// RetryPolicy of a DynamoDBService. Returns the total sleep time
+func Sleep(ms int) int {
+	time.Sleep(time.Duration(ms) * time.Millisecond)
+
+	return ms
+}
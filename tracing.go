@@ -0,0 +1,232 @@
+package dynamodbcopy
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/uniplaces/dynamodbcopy"
+
+// Span attributes
+const (
+	attributeTable          = "aws.dynamodb.table"
+	attributeSegment        = "aws.dynamodb.segment"
+	attributeItems          = "aws.dynamodb.items"
+	attributeUnprocessed    = "aws.dynamodb.unprocessed_items"
+	attributeCapacityUnits  = "aws.dynamodb.consumed_capacity_units"
+	attributeAttempt        = "retry.attempt"
+	attributeThrottleReason = "throttle.reason"
+	attributeSourceTable    = "dynamodbcopy.source_table"
+	attributeTargetTable    = "dynamodbcopy.target_table"
+)
+
+// Trace exporters
+const (
+	TraceExporterOTLP = "otlp"
+	TraceExporterFile = "file"
+)
+
+// Tracing records OpenTelemetry spans of a copy. A nil *Tracing is valid and doesn't record anything
+type Tracing struct {
+	provider *sdktrace.TracerProvider
+	tracer   trace.Tracer
+	mutex    *sync.RWMutex
+	parent   context.Context
+}
+
+// NewTracing returns a new Tracing exporting its spans in batches with the given exporter
+func NewTracing(exporter sdktrace.SpanExporter) *Tracing {
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", "dynamodbcopy"))),
+	)
+
+	return &Tracing{
+		provider: provider,
+		tracer:   provider.Tracer(tracerName),
+		mutex:    &sync.RWMutex{},
+		parent:   context.Background(),
+	}
+}
+
+type fileExporter struct {
+	sdktrace.SpanExporter
+	file *os.File
+}
+
+// Shutdown flushes the spans into the file before closing it
+func (e fileExporter) Shutdown(ctx context.Context) error {
+	if err := e.SpanExporter.Shutdown(ctx); err != nil {
+		return err
+	}
+
+	return e.file.Close()
+}
+
+// NewTraceExporter returns a span exporter of the given kind:
+//
+//	otlp - exports the spans with OTLP over HTTP to the target URL (e.g. http://localhost:4318), or to the
+//	       endpoint of the OTEL_EXPORTER_OTLP_* environment variables when target is empty
+//	file - writes the spans as JSON into the target file
+func NewTraceExporter(kind, target string) (sdktrace.SpanExporter, error) {
+	switch kind {
+	case TraceExporterOTLP:
+		var options []otlptracehttp.Option
+		if target != "" {
+			options = append(options, otlptracehttp.WithEndpointURL(target))
+		}
+
+		return otlptracehttp.New(context.Background(), options...)
+	case TraceExporterFile:
+		if target == "" {
+			return nil, fmt.Errorf("the %s trace exporter requires a file path", kind)
+		}
+
+		file, err := os.Create(target)
+		if err != nil {
+			return nil, err
+		}
+
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(file))
+		if err != nil {
+			file.Close()
+
+			return nil, err
+		}
+
+		return fileExporter{exporter, file}, nil
+	default:
+		return nil, fmt.Errorf("invalid trace exporter %q: expected otlp or file", kind)
+	}
+}
+
+// StartCopy starts the span of a whole copy, parent of the spans started afterwards.
+// It returns the function ending the span, recording the error the copy failed with, if any
+func (t *Tracing) StartCopy(srcTableName, trgTableName string) func(err error) {
+	if t == nil {
+		return func(error) {}
+	}
+
+	ctx, span := t.tracer.Start(
+		context.Background(),
+		"copy",
+		trace.WithAttributes(
+			attribute.String(attributeSourceTable, srcTableName),
+			attribute.String(attributeTargetTable, trgTableName),
+		),
+	)
+
+	t.mutex.Lock()
+	t.parent = ctx
+	t.mutex.Unlock()
+
+	return func(err error) {
+		endSpan(span, err)
+	}
+}
+
+// Shutdown ends the tracing, exporting the remaining spans
+func (t *Tracing) Shutdown() error {
+	if t == nil {
+		return nil
+	}
+
+	return t.provider.Shutdown(context.Background())
+}
+
+// start starts a span, child of the copy span when there is one, or a no-op span when t is nil
+func (t *Tracing) start(name string, attributes ...attribute.KeyValue) trace.Span {
+	if t == nil {
+		return trace.SpanFromContext(context.Background())
+	}
+
+	t.mutex.RLock()
+	parent := t.parent
+	t.mutex.RUnlock()
+
+	_, span := t.tracer.Start(parent, name, trace.WithAttributes(attributes...))
+
+	return span
+}
+
+// endSpan ends the span, setting its status to error when err isn't nil
+func endSpan(span trace.Span, err error) {
+	recordSpanError(span, err)
+	span.End()
+}
+
+// recordSpanError records the error in the span, setting its status to error, unless err is nil
+func recordSpanError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// pageTracer traces each page of a paginated request, from the hand-off of the previous page (or the start of
+// the request) to its own reception, counting the pages received
+type pageTracer struct {
+	tracing    *Tracing
+	name       string
+	attributes []attribute.KeyValue
+	span       trace.Span
+	received   int
+}
+
+func newPageTracer(tracing *Tracing, name string, attributes ...attribute.KeyValue) *pageTracer {
+	return &pageTracer{
+		tracing:    tracing,
+		name:       name,
+		attributes: attributes,
+		span:       tracing.start(name, attributes...),
+	}
+}
+
+// page ends the span of a received page
+func (p *pageTracer) page(items int64, consumed *dynamodb.ConsumedCapacity) {
+	p.span.SetAttributes(
+		attribute.Int64(attributeItems, items),
+		attribute.Float64(attributeCapacityUnits, capacityUnits(consumed)),
+	)
+	p.span.End()
+	p.span = nil
+	p.received++
+}
+
+// next starts the span of the next page unless it was the last page. It's called once the received page was
+// handed off, so that the time spent waiting for its consumer isn't attributed to the next page
+func (p *pageTracer) next(lastPage bool) {
+	if !lastPage {
+		p.span = p.tracing.start(p.name, p.attributes...)
+	}
+}
+
+// end ends the span of a page that wasn't received, recording the error the request failed with
+func (p *pageTracer) end(err error) {
+	if p.span == nil {
+		return
+	}
+
+	endSpan(p.span, err)
+	p.span = nil
+}
+
+// restart starts the span of the next page when the request is retried after failing
+func (p *pageTracer) restart() {
+	if p.span == nil {
+		p.span = p.tracing.start(p.name, p.attributes...)
+	}
+}
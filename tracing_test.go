@@ -0,0 +1,250 @@
+package dynamodbcopy_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/uniplaces/dynamodbcopy"
+	"github.com/uniplaces/dynamodbcopy/mocks"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// spanExporter keeps the exported spans after the shutdown of the tracing
+type spanExporter struct {
+	*tracetest.InMemoryExporter
+}
+
+func (spanExporter) Shutdown(context.Context) error {
+	return nil
+}
+
+func newTestTracing() (*dynamodbcopy.Tracing, spanExporter) {
+	exporter := spanExporter{tracetest.NewInMemoryExporter()}
+
+	return dynamodbcopy.NewTracing(exporter), exporter
+}
+
+func newTracedService(api *mocks.DynamoDBAPI, tracing *dynamodbcopy.Tracing) dynamodbcopy.DynamoDBService {
+	return dynamodbcopy.NewDynamoDBService(
+		expectedTableName,
+		api,
+		testSleeper,
+		log.New(ioutil.Discard, "", log.Ltime),
+		dynamodbcopy.WithTracing(tracing),
+	)
+}
+
+func spanAttributes(span tracetest.SpanStub) map[attribute.Key]attribute.Value {
+	attributes := make(map[attribute.Key]attribute.Value, len(span.Attributes))
+	for _, kv := range span.Attributes {
+		attributes[kv.Key] = kv.Value
+	}
+
+	return attributes
+}
+
+func TestTracingBatchWrite(t *testing.T) {
+	t.Parallel()
+
+	batchInput := buildBatchWriteItemInput(10)
+	throttleErr := awserr.New("ThrottlingException", "err", errors.New("throttled"))
+
+	api := &mocks.DynamoDBAPI{}
+	api.On("BatchWriteItem", &batchInput).Return(nil, throttleErr).Once()
+	api.On("BatchWriteItem", &batchInput).Return(&dynamodb.BatchWriteItemOutput{}, nil).Once()
+
+	tracing, exporter := newTestTracing()
+	endCopy := tracing.StartCopy("src", "trg")
+
+	require.Nil(t, newTracedService(api, tracing).BatchWrite(getItems(batchInput)))
+
+	endCopy(nil)
+	require.Nil(t, tracing.Shutdown())
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 3)
+
+	throttled, written, copied := spans[0], spans[1], spans[2]
+
+	assert.Equal(t, "copy", copied.Name)
+	assert.Equal(t, "src", spanAttributes(copied)["dynamodbcopy.source_table"].AsString())
+
+	assert.Equal(t, "dynamodb.BatchWriteItem", throttled.Name)
+	assert.Equal(t, copied.SpanContext.SpanID(), throttled.Parent.SpanID())
+	assert.Equal(t, codes.Error, throttled.Status.Code)
+	assert.Equal(t, int64(0), spanAttributes(throttled)["retry.attempt"].AsInt64())
+	assert.Equal(t, "throttling", spanAttributes(throttled)["throttle.reason"].AsString())
+
+	assert.Equal(t, "dynamodb.BatchWriteItem", written.Name)
+	assert.Equal(t, codes.Unset, written.Status.Code)
+	assert.Equal(t, int64(1), spanAttributes(written)["retry.attempt"].AsInt64())
+	assert.Equal(t, int64(0), spanAttributes(written)["aws.dynamodb.unprocessed_items"].AsInt64())
+
+	api.AssertExpectations(t)
+}
+
+func TestTracingScanPages(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		subTestName   string
+		err           error
+		expectedSpans int
+	}{
+		{"Success", nil, 2},
+		{"Error", errors.New("scan error"), 3},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(
+			testCase.subTestName,
+			func(st *testing.T) {
+				api := &mocks.DynamoDBAPI{}
+				api.On("ScanPages", buildScanInput(4, 1), mock.Anything).
+					Run(func(args mock.Arguments) {
+						pager := args.Get(1).(func(*dynamodb.ScanOutput, bool) bool)
+						item := map[string]*dynamodb.AttributeValue{"id": {S: aws.String("id")}}
+						pager(&dynamodb.ScanOutput{Items: []map[string]*dynamodb.AttributeValue{item, item}}, false)
+						pager(&dynamodb.ScanOutput{Items: []map[string]*dynamodb.AttributeValue{item}}, testCase.err == nil)
+					}).
+					Return(testCase.err).
+					Once()
+
+				tracing, exporter := newTestTracing()
+
+				// the pages are received slowly, which must not be attributed to the next page span
+				itemsChan := make(chan []dynamodbcopy.DynamoDBItem)
+				go func() {
+					for {
+						time.Sleep(20 * time.Millisecond)
+						if _, ok := <-itemsChan; !ok {
+							return
+						}
+					}
+				}()
+				err := newTracedService(api, tracing).Scan(4, 1, itemsChan)
+				close(itemsChan)
+				assertExpectedError(st, testCase.err != nil, err)
+
+				require.Nil(st, tracing.Shutdown())
+
+				spans := exporter.GetSpans()
+				require.Len(st, spans, testCase.expectedSpans)
+				for _, span := range spans {
+					assert.Equal(st, "dynamodb.ScanPage", span.Name)
+					assert.Equal(st, int64(1), spanAttributes(span)["aws.dynamodb.segment"].AsInt64())
+				}
+				assert.Equal(st, int64(2), spanAttributes(spans[0])["aws.dynamodb.items"].AsInt64())
+				assert.Equal(st, int64(1), spanAttributes(spans[1])["aws.dynamodb.items"].AsInt64())
+				assert.True(st, spans[1].StartTime.Sub(spans[0].EndTime) >= 20*time.Millisecond)
+
+				if testCase.err != nil {
+					assert.Equal(st, codes.Error, spans[2].Status.Code)
+				}
+
+				api.AssertExpectations(st)
+			},
+		)
+	}
+}
+
+func TestTracingUpdateCapacity(t *testing.T) {
+	t.Parallel()
+
+	api := &mocks.DynamoDBAPI{}
+	api.On("UpdateTable", mock.AnythingOfType("*dynamodb.UpdateTableInput")).
+		Return(&dynamodb.UpdateTableOutput{}, nil).
+		Once()
+	api.On("DescribeTable", mock.AnythingOfType("*dynamodb.DescribeTableInput")).
+		Return(buildDescribeTableOutput(expectedTableName, dynamodb.TableStatusUpdating), nil).
+		Once()
+	api.On("DescribeTable", mock.AnythingOfType("*dynamodb.DescribeTableInput")).
+		Return(buildDescribeTableOutput(expectedTableName, dynamodb.TableStatusActive), nil).
+		Once()
+
+	tracing, exporter := newTestTracing()
+
+	require.Nil(t, newTracedService(api, tracing).UpdateCapacity(dynamodbcopy.Capacity{Read: 10, Write: 10}))
+	require.Nil(t, tracing.Shutdown())
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+
+	assert.Equal(t, "dynamodb.UpdateTable", spans[0].Name)
+	assert.Equal(t, "dynamodb.WaitForReadyTable", spans[1].Name)
+	assert.Equal(t, int64(1), spanAttributes(spans[1])["retry.attempt"].AsInt64())
+	assert.Equal(t, expectedTableName, spanAttributes(spans[1])["aws.dynamodb.table"].AsString())
+
+	api.AssertExpectations(t)
+}
+
+func TestNewTraceExporter(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(os.TempDir(), "dynamodbcopy-traces-test.json")
+	defer os.Remove(path)
+
+	testCases := []struct {
+		subTestName   string
+		kind          string
+		target        string
+		errorExpected bool
+	}{
+		{"InvalidExporter", "zipkin", "", true},
+		{"FileWithoutPath", dynamodbcopy.TraceExporterFile, "", true},
+		{"File", dynamodbcopy.TraceExporterFile, path, false},
+		{"OTLP", dynamodbcopy.TraceExporterOTLP, "http://localhost:4318", false},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(
+			testCase.subTestName,
+			func(st *testing.T) {
+				exporter, err := dynamodbcopy.NewTraceExporter(testCase.kind, testCase.target)
+
+				assertExpectedError(st, testCase.errorExpected, err)
+				if err != nil {
+					return
+				}
+
+				assert.Nil(st, exporter.Shutdown(context.Background()))
+			},
+		)
+	}
+}
+
+func TestTracingFileExporter(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(os.TempDir(), "dynamodbcopy-traces-file-test.json")
+	defer os.Remove(path)
+
+	exporter, err := dynamodbcopy.NewTraceExporter(dynamodbcopy.TraceExporterFile, path)
+	require.Nil(t, err)
+
+	tracing := dynamodbcopy.NewTracing(exporter)
+	tracing.StartCopy("src", "trg")(errors.New("copy error"))
+	require.Nil(t, tracing.Shutdown())
+
+	content, err := ioutil.ReadFile(path)
+	require.Nil(t, err)
+
+	assert.Contains(t, string(content), `"Name":"copy"`)
+	assert.Contains(t, string(content), "copy error")
+}
@@ -0,0 +1,70 @@
+package dynamodbcopy
+
+import "sync/atomic"
+
+// Truncater is the interface that allows you to delete all the items of a table
+type Truncater interface {
+	Truncate(readers, writers int) error
+}
+
+type truncateService struct {
+	table      DynamoDBService
+	copierChan CopierChan
+	report     *WriteReport
+	logger     Logger
+}
+
+// NewTruncater returns a new Truncater to delete all the items of the given table
+func NewTruncater(tableService DynamoDBService, copierChan CopierChan, report *WriteReport, logger Logger) Truncater {
+	return truncateService{
+		table:      tableService,
+		copierChan: copierChan,
+		report:     report,
+		logger:     logger,
+	}
+}
+
+// Truncate deletes all the items of the table, reusing the Copier's worker pool: the readers perform a parallel scan
+// of the item keys only, while the writers delete them in batches
+func (service truncateService) Truncate(readers, writers int) error {
+	service.logger.Printf("truncating table with %d readers and %d writers", readers, writers)
+
+	description, err := service.table.DescribeTable()
+	if err != nil {
+		return err
+	}
+
+	copier := NewCopier(
+		keyScanningService{service.table, keyNames(description)},
+		deletingService{service.table, service.report},
+		service.copierChan,
+		service.logger,
+	)
+
+	return copier.Copy(readers, writers)
+}
+
+type keyScanningService struct {
+	DynamoDBService
+	keyNames []string
+}
+
+// Scan performs a scan over the wrapped DynamoDBService retrieving only the key attributes of the items
+func (s keyScanningService) Scan(totalSegments, segment int, itemsChan chan<- []DynamoDBItem) error {
+	return s.ScanWithOptions(ScanOptions{ProjectionAttributes: s.keyNames}, totalSegments, segment, itemsChan)
+}
+
+type deletingService struct {
+	DynamoDBService
+	report *WriteReport
+}
+
+// BatchWrite deletes the items with the given keys from the wrapped DynamoDBService
+func (s deletingService) BatchWrite(keys []DynamoDBItem) error {
+	if err := s.BatchDelete(keys); err != nil {
+		return err
+	}
+	atomic.AddInt64(&s.report.deleted, int64(len(keys)))
+
+	return nil
+}
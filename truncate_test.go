@@ -0,0 +1,131 @@
+package dynamodbcopy_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/uniplaces/dynamodbcopy"
+	"github.com/uniplaces/dynamodbcopy/mocks"
+)
+
+func TestTruncate(t *testing.T) {
+	t.Parallel()
+
+	description := buildKeyedTableDescription(expectedTableName, "id", dynamodb.ScalarAttributeTypeS)
+	options := dynamodbcopy.ScanOptions{ProjectionAttributes: []string{"id"}}
+	keys := []dynamodbcopy.DynamoDBItem{buildMirrorItem("1", "a"), buildMirrorItem("2", "a")}
+
+	expectedError := errors.New("truncate error")
+
+	testCases := []struct {
+		subTestName     string
+		mocker          func(service *mocks.DynamoDBService)
+		expectedDeleted int64
+		expectedError   bool
+	}{
+		{
+			"DescribeError",
+			func(service *mocks.DynamoDBService) {
+				service.On("DescribeTable").Return(nil, expectedError).Once()
+			},
+			0,
+			true,
+		},
+		{
+			"DeleteError",
+			func(service *mocks.DynamoDBService) {
+				service.On("DescribeTable").Return(&description, nil).Once()
+				service.On("ScanWithOptions", options, 1, 0, mock.Anything).
+					Run(func(args mock.Arguments) {
+						args.Get(3).(chan<- []dynamodbcopy.DynamoDBItem) <- keys
+					}).
+					Return(nil).
+					Once()
+				service.On("BatchDelete", keys).Return(expectedError).Once()
+			},
+			0,
+			true,
+		},
+		{
+			"Success",
+			func(service *mocks.DynamoDBService) {
+				service.On("DescribeTable").Return(&description, nil).Once()
+				service.On("ScanWithOptions", options, 1, 0, mock.Anything).
+					Run(func(args mock.Arguments) {
+						args.Get(3).(chan<- []dynamodbcopy.DynamoDBItem) <- keys
+					}).
+					Return(nil).
+					Once()
+				service.On("BatchDelete", keys).Return(nil).Once()
+			},
+			2,
+			false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.subTestName,
+			func(st *testing.T) {
+				service := &mocks.DynamoDBService{}
+
+				testCase.mocker(service)
+
+				report := dynamodbcopy.NewWriteReport()
+				truncater := dynamodbcopy.NewTruncater(
+					service,
+					dynamodbcopy.NewCopierChan(1),
+					report,
+					log.New(ioutil.Discard, "", log.Ltime),
+				)
+
+				err := truncater.Truncate(1, 1)
+
+				assertExpectedError(st, testCase.expectedError, err)
+				assert.Equal(st, testCase.expectedDeleted, report.Deleted())
+
+				service.AssertExpectations(st)
+			},
+		)
+	}
+}
+
+func TestTruncateSegments(t *testing.T) {
+	t.Parallel()
+
+	description := buildKeyedTableDescription(expectedTableName, "id", dynamodb.ScalarAttributeTypeS)
+	options := dynamodbcopy.ScanOptions{ProjectionAttributes: []string{"id"}}
+	keys := []dynamodbcopy.DynamoDBItem{buildMirrorItem("1", "a")}
+
+	// the key attribute names are described once for all the scanned segments
+	service := &mocks.DynamoDBService{}
+	service.On("DescribeTable").Return(&description, nil).Once()
+	for segment := 0; segment < 2; segment++ {
+		service.On("ScanWithOptions", options, 2, segment, mock.Anything).
+			Run(func(args mock.Arguments) {
+				args.Get(3).(chan<- []dynamodbcopy.DynamoDBItem) <- keys
+			}).
+			Return(nil).
+			Once()
+	}
+	service.On("BatchDelete", keys).Return(nil).Twice()
+
+	report := dynamodbcopy.NewWriteReport()
+	truncater := dynamodbcopy.NewTruncater(
+		service,
+		dynamodbcopy.NewCopierChan(1),
+		report,
+		log.New(ioutil.Discard, "", log.Ltime),
+	)
+
+	require.Nil(t, truncater.Truncate(2, 1))
+	assert.Equal(t, int64(2), report.Deleted())
+
+	service.AssertExpectations(t)
+}